@@ -0,0 +1,48 @@
+package view
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Iterate over the maximal runs of consecutive items of the provided view for
+// which the key function returns equal keys, as subviews of the view, from
+// the first run to the last. The view is followed by its context.
+//
+// The key function is called exactly once for each item.
+func ChunkFuncUnmanaged[T comparable, Offset constraints.Unsigned, K comparable](
+	v UnmanagedView[T, Offset], ctx ViewContext[T], key func(T) K,
+) iter.Seq[UnmanagedView[T, Offset]] {
+	return func(yield func(UnmanagedView[T, Offset]) bool) {
+		if v.Len() == 0 {
+			return
+		}
+
+		start := Offset(0)
+		current := key(v.AtUnsafe(ctx, 0))
+		for idx := Offset(1); idx < v.Len(); idx++ {
+			next := key(v.AtUnsafe(ctx, idx))
+			if next == current {
+				continue
+			}
+
+			if !yield(v.Subview(start, idx)) {
+				return
+			}
+			start, current = idx, next
+		}
+
+		yield(v.Subview(start, v.Len()))
+	}
+}
+
+// Iterate over the maximal runs of consecutive items of the provided view for
+// which the key function returns equal keys, as subviews of the view.
+//
+// For example, grouping "aabccc" by identity yields "aa", "b" and "ccc".
+func ChunkFunc[T comparable, Offset constraints.Unsigned, K comparable](
+	v View[T, Offset], key func(T) K,
+) iter.Seq[View[T, Offset]] {
+	return attachSeq(v.ctx, ChunkFuncUnmanaged(v.unmanaged, v.ctx, key))
+}
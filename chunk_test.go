@@ -0,0 +1,74 @@
+package view_test
+
+import (
+	"iter"
+	"testing"
+	"unicode"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func collectStrings(seq iter.Seq[view.View[rune, uint]]) []string {
+	strs := []string{}
+	for v := range seq {
+		strs = append(strs, string(v.Raw()))
+	}
+	return strs
+}
+
+func TestWindows(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("xabcdx")).Subview(1, 5)
+	assert.Equal(t, []string{"ab", "bc", "cd"}, collectStrings(v.Windows(2)))
+	assert.Equal(t, []string{"abcd"}, collectStrings(v.Windows(4)))
+	assert.Equal(t, []string{}, collectStrings(v.Windows(5)))
+
+	for window := range v.Windows(3) {
+		assert.Equal(t, view.UnmanagedView[rune, uint]{Start: 1, End: 4}, window.Unmanaged())
+		assert.Same(t, &v.Ctx()[0], &window.Ctx()[0])
+		break
+	}
+
+	assert.Panics(t, func() { v.Windows(0) })
+}
+
+func TestChunks(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("xabcdex")).Subview(1, 6)
+	assert.Equal(t, []string{"ab", "cd", "e"}, collectStrings(v.Chunks(2)))
+	assert.Equal(t, []string{"abcde"}, collectStrings(v.Chunks(5)))
+	assert.Equal(t, []string{"abcde"}, collectStrings(v.Chunks(10)))
+	assert.Equal(t, []string{}, collectStrings(v.Subview(2, 2).Chunks(3)))
+
+	got := [][]rune{}
+	for chunk := range v.Chunks(3) {
+		got = append(got, chunk.Raw())
+	}
+	assert.Equal(t, [][]rune{[]rune("abc"), []rune("de")}, got)
+
+	assert.Panics(t, func() { v.Chunks(0) })
+}
+
+func TestChunkFunc(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("#aab ccc12#")).Subview(1, 10)
+	assert.Equal(t,
+		[]string{"aa", "b", " ", "ccc", "1", "2"},
+		collectStrings(view.ChunkFunc(v, func(r rune) rune { return r })),
+	)
+	assert.Equal(t,
+		[]string{"aab", " ", "ccc", "12"},
+		collectStrings(view.ChunkFunc(v, unicode.IsLetter)),
+	)
+	assert.Equal(t, []string{}, collectStrings(view.ChunkFunc(v.Subview(0, 0), unicode.IsLetter)))
+
+	calls := 0
+	for range view.ChunkFunc(v, func(r rune) bool { calls++; return unicode.IsDigit(r) }) {
+	}
+	assert.Equal(t, int(v.Len()), calls)
+
+	chunks := []string{}
+	for chunk := range view.ChunkFunc(v, unicode.IsLetter) {
+		chunks = append(chunks, string(chunk.Raw()))
+		break
+	}
+	assert.Equal(t, []string{"aab"}, chunks)
+}
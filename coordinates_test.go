@@ -0,0 +1,183 @@
+package view_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+// A differential test suite for all methods that produce subviews. Each method
+// is run on nested subviews with non-zero start offsets, and its output is
+// compared to a naive oracle over the corresponding slice.
+//
+// Subviews are compared by their absolute bounds in the context, not only by
+// their content, so a subview with view-relative offsets is always caught.
+
+// A subview, as an absolute range of its context.
+type span struct {
+	Start, End uint
+}
+
+func spanOf[T comparable](v view.View[T, uint]) span {
+	unmanaged := v.Unmanaged()
+	return span{unmanaged.Start, unmanaged.End}
+}
+
+func spansOf[T comparable](views []view.View[T, uint]) []span {
+	spans := make([]span, len(views))
+	for idx, v := range views {
+		spans[idx] = spanOf(v)
+	}
+	return spans
+}
+
+// Returns a random nested subview of a random context, whose items are drawn
+// from the provided alphabet, together with the absolute start offset of the
+// subview and its content.
+func randomNestedView[T comparable](random *rand.Rand, alphabet []T) (view.View[T, uint], uint, []T) {
+	data := make([]T, random.IntN(24))
+	for idx := range data {
+		data[idx] = alphabet[random.IntN(len(alphabet))]
+	}
+
+	v := view.NewView[T, uint](data)
+	start, end := uint(0), uint(len(data))
+	for depth := random.IntN(4); depth > 0; depth-- {
+		lo := uint(random.IntN(int(end-start) + 1))
+		hi := lo + uint(random.IntN(int(end-start-lo)+1))
+		v = v.Subview(lo, hi)
+		start, end = start+lo, start+hi
+	}
+
+	return v, start, data[start:end]
+}
+
+// The alphabet of the integer views used by the oracle tests.
+var oracleAlphabet = []int{0, 1, 2}
+
+// The alphabet of the text views that are compared against the strings
+// package.
+var textAlphabet = []byte(" ab=")
+
+// Asserts that the provided view is the subview at the expected absolute span,
+// with the expected content.
+func assertSubview(t *testing.T, expected span, content string, v view.View[byte, uint]) {
+	t.Helper()
+	assert.Equal(t, expected, spanOf(v))
+	assert.Equal(t, content, string(v.Raw()))
+}
+
+func TestSubviewMatchesOracle(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 21))
+	for iteration := 0; iteration < 500; iteration++ {
+		v, start, items := randomNestedView(random, oracleAlphabet)
+		lo := uint(random.IntN(len(items) + 2))
+		hi := uint(random.IntN(len(items) + 2))
+
+		// Subview clamps its bounds to the view.
+		end := min(hi, uint(len(items)))
+		begin := min(lo, end)
+
+		sub := v.Subview(lo, hi)
+		assert.Equal(t, span{start + begin, start + end}, spanOf(sub))
+		assert.Equal(t, items[begin:end], sub.Raw())
+	}
+}
+
+func TestPartitionMatchesOracle(t *testing.T) {
+	random := rand.New(rand.NewPCG(2, 21))
+	for iteration := 0; iteration < 500; iteration++ {
+		v, start, items := randomNestedView(random, oracleAlphabet)
+		index := uint(random.IntN(len(items) + 1))
+
+		a, b := v.Partition(index)
+		assert.Equal(t, span{start, start + index}, spanOf(a))
+		assert.Equal(t, span{start + index, start + uint(len(items))}, spanOf(b))
+		assert.Equal(t, items[:index], a.Raw())
+		assert.Equal(t, items[index:], b.Raw())
+	}
+}
+
+func TestFieldsMatchesOracle(t *testing.T) {
+	isSep := func(item int) bool { return item == 0 }
+
+	random := rand.New(rand.NewPCG(3, 21))
+	for iteration := 0; iteration < 500; iteration++ {
+		v, start, items := randomNestedView(random, oracleAlphabet)
+
+		expected := []span{}
+		for i := 0; i < len(items); {
+			if isSep(items[i]) {
+				i++
+				continue
+			}
+
+			j := i
+			for j < len(items) && !isSep(items[j]) {
+				j++
+			}
+			expected = append(expected, span{start + uint(i), start + uint(j)})
+			i = j
+		}
+
+		assert.Equal(t, expected, spansOf(v.Fields(isSep)))
+
+		seq := []span{}
+		for field := range v.FieldsSeq(isSep) {
+			seq = append(seq, spanOf(field))
+		}
+		assert.Equal(t, expected, seq)
+	}
+}
+
+func TestLongestCommonPrefixSuffixMatchesOracle(t *testing.T) {
+	random := rand.New(rand.NewPCG(4, 21))
+	for iteration := 0; iteration < 500; iteration++ {
+		v, start, items := randomNestedView(random, oracleAlphabet)
+		u, _, other := randomNestedView(random, oracleAlphabet)
+
+		n := min(len(items), len(other))
+		prefix := 0
+		for prefix < n && items[prefix] == other[prefix] {
+			prefix++
+		}
+
+		suffix := 0
+		for suffix < n && items[len(items)-1-suffix] == other[len(other)-1-suffix] {
+			suffix++
+		}
+
+		length := uint(len(items))
+		assert.Equal(t, span{start, start + uint(prefix)}, spanOf(v.LongestCommonPrefix(u)))
+		assert.Equal(t, span{start + length - uint(suffix), start + length}, spanOf(v.LongestCommonSuffix(u)))
+	}
+}
+
+func TestSplitMatchesOracle(t *testing.T) {
+	random := rand.New(rand.NewPCG(5, 21))
+	for iteration := 0; iteration < 500; iteration++ {
+		v, start, items := randomNestedView(random, oracleAlphabet)
+		sepItems := make([]int, 1+random.IntN(2))
+		for idx := range sepItems {
+			sepItems[idx] = random.IntN(3)
+		}
+		sep := view.NewView[int, uint](sepItems)
+
+		expected := []span{}
+		begin := 0
+		for i := 0; i+len(sepItems) <= len(items); {
+			if view.NewView[int, uint](items[i : i+len(sepItems)]).Equal(sep) {
+				expected = append(expected, span{start + uint(begin), start + uint(i)})
+				i += len(sepItems)
+				begin = i
+			} else {
+				i++
+			}
+		}
+		expected = append(expected, span{start + uint(begin), start + uint(len(items))})
+
+		assert.Equal(t, expected, spansOf(v.Split(sep)))
+	}
+}
@@ -0,0 +1,173 @@
+package view
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A span in source text, with a message that describes it.
+// The message may be empty.
+type Label[T comparable, Offset constraints.Unsigned] struct {
+	View    UnmanagedView[T, Offset]
+	Message string
+}
+
+// A Renderer renders annotated snippets of source text, with the annotated
+// spans underlined, in the style of rustc and clang diagnostics:
+//
+//	2 | let x = foo(bar,
+//	  |         ^~~~~~~~
+//	3 |             baz);
+//	  | ~~~~~~~~~~~~~~~~ no function named 'foo'
+//	  |             --- defined here
+//
+// The primary span is underlined with '^' at its first character, followed by
+// '~', and secondary spans are underlined with '-'. Tabs are expanded to spaces
+// according to the tab width of the line index.
+type Renderer[T Char, Offset constraints.Unsigned] struct {
+	ctx   ViewContext[T]
+	index *LineIndex[T, Offset]
+}
+
+// Create a new renderer for spans in the provided context.
+// The provided line index must be built over the same context.
+func NewRenderer[T Char, Offset constraints.Unsigned](
+	ctx ViewContext[T], index *LineIndex[T, Offset],
+) *Renderer[T, Offset] {
+	return &Renderer[T, Offset]{ctx: ctx, index: index}
+}
+
+// The visual columns [start, end) of a label on a single line.
+type rendererSegment struct {
+	label      int
+	start, end int
+	first      bool
+	last       bool
+}
+
+// Render the source lines covered by the provided labels, each followed by
+// the underlines of the labels on that line. The message of each label is
+// printed after its underline on the last line of the label span.
+//
+// All labels must refer to the context of the renderer. Non consecutive lines
+// are separated by a "..." line.
+func (r *Renderer[T, Offset]) Render(primary Label[T, Offset], secondary ...Label[T, Offset]) string {
+	labels := append([]Label[T, Offset]{primary}, secondary...)
+
+	segments := make(map[int][]rendererSegment)
+	for idx, label := range labels {
+		start, end := r.index.Span(label.View)
+
+		// A span that ends right after a line terminator is rendered as if it
+		// ends at the end of the previous line.
+		lastLine := end.Line
+		if end.Line > start.Line && end.Column == 1 {
+			lastLine--
+		}
+
+		for line := start.Line; line <= lastLine; line++ {
+			segment := rendererSegment{
+				label: idx,
+				start: 1,
+				end:   r.lineWidth(line) + 1,
+				first: line == start.Line,
+				last:  line == lastLine,
+			}
+
+			if segment.first {
+				segment.start = start.Column
+			}
+
+			if segment.last && end.Line == line {
+				segment.end = end.Column
+			}
+
+			// Always underline at least a single column, so empty spans and spans
+			// that only cover line terminators are still visible.
+			segment.end = max(segment.end, segment.start+1)
+			segments[line] = append(segments[line], segment)
+		}
+	}
+
+	lines := make([]int, 0, len(segments))
+	for line := range segments {
+		lines = append(lines, line)
+	}
+	slices.Sort(lines)
+
+	gutter := len(strconv.Itoa(lines[len(lines)-1]))
+	var builder strings.Builder
+
+	for i, line := range lines {
+		if i > 0 && line > lines[i-1]+1 {
+			builder.WriteString("...\n")
+		}
+
+		number := strconv.Itoa(line)
+		builder.WriteString(strings.Repeat(" ", gutter-len(number)))
+		builder.WriteString(number)
+		builder.WriteString(" | ")
+		r.writeLine(&builder, line)
+		builder.WriteByte('\n')
+
+		for _, segment := range segments[line] {
+			builder.WriteString(strings.Repeat(" ", gutter))
+			builder.WriteString(" | ")
+			builder.WriteString(strings.Repeat(" ", segment.start-1))
+
+			width := segment.end - segment.start
+			if segment.label == 0 {
+				if segment.first {
+					builder.WriteByte('^')
+					width--
+				}
+				builder.WriteString(strings.Repeat("~", width))
+			} else {
+				builder.WriteString(strings.Repeat("-", width))
+			}
+
+			message := labels[segment.label].Message
+			if segment.last && message != "" {
+				builder.WriteByte(' ')
+				builder.WriteString(message)
+			}
+			builder.WriteByte('\n')
+		}
+	}
+
+	return builder.String()
+}
+
+// Returns the visual width of the provided line, excluding its terminator.
+func (r *Renderer[T, Offset]) lineWidth(line int) int {
+	return r.index.Position(r.index.Line(line).End).Column - 1
+}
+
+// Write the content of the provided line, with tabs expanded to spaces.
+func (r *Renderer[T, Offset]) writeLine(builder *strings.Builder, line int) {
+	isByte := isByteChar[T]()
+	tabWidth := r.index.TabWidth()
+	column := 1
+
+	for item := range r.index.Line(line).Range(r.ctx) {
+		switch {
+		case item == '\t':
+			next := nextTabStop(column, tabWidth)
+			builder.WriteString(strings.Repeat(" ", next-column))
+			column = next
+
+		case isByte:
+			builder.WriteByte(byte(item))
+			if !isContinuationByte(item) {
+				column++
+			}
+
+		default:
+			builder.WriteRune(rune(item))
+			column++
+		}
+	}
+}
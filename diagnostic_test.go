@@ -0,0 +1,89 @@
+package view_test
+
+import (
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRendererSingleLine(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("let x = foo(bar);\n"))
+	index, err := view.NewLineIndex[rune, uint](v.Ctx(), 4)
+	assert.NoError(t, err)
+	renderer := view.NewRenderer(v.Ctx(), index)
+
+	got := renderer.Render(view.Label[rune, uint]{
+		View:    v.Subview(8, 11).Unmanaged(),
+		Message: "unknown function",
+	})
+
+	expected := "" +
+		"1 | let x = foo(bar);\n" +
+		"  |         ^~~ unknown function\n"
+	assert.Equal(t, expected, got)
+}
+
+func TestRendererMultiLineWithSecondary(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("let x = foo(bar,\n            baz);\n"))
+	index, err := view.NewLineIndex[rune, uint](v.Ctx(), 4)
+	assert.NoError(t, err)
+	renderer := view.NewRenderer(v.Ctx(), index)
+
+	got := renderer.Render(
+		view.Label[rune, uint]{
+			View:    v.Subview(8, 33).Unmanaged(),
+			Message: "no function named 'foo'",
+		},
+		view.Label[rune, uint]{
+			View:    v.Subview(29, 32).Unmanaged(),
+			Message: "defined here",
+		},
+	)
+
+	expected := "" +
+		"1 | let x = foo(bar,\n" +
+		"  |         ^~~~~~~~\n" +
+		"2 |             baz);\n" +
+		"  | ~~~~~~~~~~~~~~~~ no function named 'foo'\n" +
+		"  |             --- defined here\n"
+	assert.Equal(t, expected, got)
+}
+
+func TestRendererTabsAndGaps(t *testing.T) {
+	lines := "a\n\tb\nc\nd\ne\nf\ng\nh\ni\nj\tk\n"
+	v := view.NewView[byte, uint32]([]byte(lines))
+	index, err := view.NewLineIndex[byte, uint32](v.Ctx(), 4)
+	assert.NoError(t, err)
+	renderer := view.NewRenderer(v.Ctx(), index)
+
+	got := renderer.Render(
+		view.Label[byte, uint32]{View: v.Subview(3, 4).Unmanaged()},
+		view.Label[byte, uint32]{View: v.Subview(21, 22).Unmanaged(), Message: "here"},
+	)
+
+	expected := "" +
+		" 2 |     b\n" +
+		"   |     ^\n" +
+		"...\n" +
+		"10 | j   k\n" +
+		"   |     - here\n"
+	assert.Equal(t, expected, got)
+}
+
+func TestRendererEmptySpan(t *testing.T) {
+	v := view.NewView[byte, uint]([]byte("héllo"))
+	index, err := view.NewLineIndex[byte, uint](v.Ctx(), 4)
+	assert.NoError(t, err)
+	renderer := view.NewRenderer(v.Ctx(), index)
+
+	got := renderer.Render(view.Label[byte, uint]{
+		View:    v.Subview(6, 6).Unmanaged(),
+		Message: "expected ';'",
+	})
+
+	expected := "" +
+		"1 | héllo\n" +
+		"  |      ^ expected ';'\n"
+	assert.Equal(t, expected, got)
+}
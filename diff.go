@@ -0,0 +1,410 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// The kind of an edit operation in an edit script.
+type EditOp uint8
+
+const (
+	// A span that appears in both the old and the new view.
+	EditEqual EditOp = iota
+
+	// A span of the old view that does not appear in the new view.
+	EditDelete
+
+	// A span of the new view that does not appear in the old view.
+	EditInsert
+)
+
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "Equal"
+	case EditDelete:
+		return "Delete"
+	case EditInsert:
+		return "Insert"
+	default:
+		return fmt.Sprintf("EditOp(%d)", uint8(op))
+	}
+}
+
+// A single operation in an edit script that transforms an old view into a new
+// view. Old and New are spans in the contexts of the old and the new views.
+//
+// For EditEqual, both spans have equal content. For EditDelete, the New span
+// is empty, and marks the position in the new view where the deleted span
+// would have been. Similarly, for EditInsert, the Old span is empty.
+type Edit[T comparable, Offset constraints.Unsigned] struct {
+	Op       EditOp
+	Old, New UnmanagedView[T, Offset]
+}
+
+// Computes a shortest edit script that transforms the view from into the view
+// to, using Myers' O(ND) difference algorithm, in its linear space variant.
+// Each view is followed by its context.
+//
+// Consecutive operations of the same kind are merged, and in each run of
+// changes between two equal spans, the deletion precedes the insertion. The
+// concatenation of the old spans is the view from, and the concatenation of
+// the new spans is the view to.
+func DiffUnmanaged[T comparable, Offset constraints.Unsigned](
+	from UnmanagedView[T, Offset], fromCtx ViewContext[T],
+	to UnmanagedView[T, Offset], toCtx ViewContext[T],
+) []Edit[T, Offset] {
+	d := differ[T, Offset]{
+		from: from,
+		to:   to,
+		eq: func(i, j int) bool {
+			return from.AtUnsafe(fromCtx, Offset(i)) == to.AtUnsafe(toCtx, Offset(j))
+		},
+	}
+
+	d.diff(0, int(from.Len()), 0, int(to.Len()))
+	d.flush()
+	return d.edits
+}
+
+// Computes a shortest edit script that transforms the view from into the view
+// to. See DiffUnmanaged for more details.
+func Diff[T comparable, Offset constraints.Unsigned](from, to View[T, Offset]) []Edit[T, Offset] {
+	return DiffUnmanaged(from.unmanaged, from.ctx, to.unmanaged, to.ctx)
+}
+
+// Holds the state of a single diff computation.
+// Positions are relative to the start of the from and to views.
+type differ[T comparable, Offset constraints.Unsigned] struct {
+	from, to UnmanagedView[T, Offset]
+	eq       func(i, j int) bool
+	edits    []Edit[T, Offset]
+
+	// The current positions in both views, and the number of deleted and
+	// inserted items that end at the current positions, and are not yet
+	// recorded as edits.
+	x, y              int
+	deleted, inserted int
+}
+
+// Compute the diff of from[x0:x1] and to[y0:y1], and record it.
+func (d *differ[T, Offset]) diff(x0, x1, y0, y1 int) {
+	prefix := 0
+	for x0+prefix < x1 && y0+prefix < y1 && d.eq(x0+prefix, y0+prefix) {
+		prefix++
+	}
+	d.equal(prefix)
+	x0, y0 = x0+prefix, y0+prefix
+
+	suffix := 0
+	for x0 < x1-suffix && y0 < y1-suffix && d.eq(x1-suffix-1, y1-suffix-1) {
+		suffix++
+	}
+	x1, y1 = x1-suffix, y1-suffix
+
+	if x0 == x1 || y0 == y1 {
+		d.delete(x1 - x0)
+		d.insert(y1 - y0)
+	} else if x, y, ok := d.middleSnake(x0, x1, y0, y1); ok {
+		d.diff(x0, x, y0, y)
+		d.diff(x, x1, y, y1)
+	} else {
+		d.delete(x1 - x0)
+		d.insert(y1 - y0)
+	}
+
+	d.equal(suffix)
+}
+
+// Find a point on an optimal edit path of from[x0:x1] and to[y0:y1], by
+// extending furthest reaching paths from both ends until they overlap.
+// Returns false if the ranges have no items in common.
+func (d *differ[T, Offset]) middleSnake(x0, x1, y0, y1 int) (int, int, bool) {
+	n, m := x1-x0, y1-y0
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	length := 2*maxD + 2
+
+	// forward[k] and backward[k] hold the furthest x reached on diagonal k, in
+	// the forward and the backward searches respectively.
+	forward := make([]int, length)
+	backward := make([]int, length)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	odd := delta%2 != 0
+	k1start, k1end, k2start, k2end := 0, 0, 0, 0
+
+	for step := 0; step < maxD; step++ {
+		for k1 := -step + k1start; k1 <= step-k1end; k1 += 2 {
+			k1offset := offset + k1
+			var x int
+			if k1 == -step || (k1 != step && forward[k1offset-1] < forward[k1offset+1]) {
+				x = forward[k1offset+1]
+			} else {
+				x = forward[k1offset-1] + 1
+			}
+			y := x - k1
+			for x < n && y < m && d.eq(x0+x, y0+y) {
+				x, y = x+1, y+1
+			}
+			forward[k1offset] = x
+
+			if x > n {
+				k1end += 2
+			} else if y > m {
+				k1start += 2
+			} else if odd {
+				k2offset := offset + delta - k1
+				if k2offset >= 0 && k2offset < length && backward[k2offset] != -1 {
+					if x >= n-backward[k2offset] {
+						return x0 + x, y0 + y, true
+					}
+				}
+			}
+		}
+
+		for k2 := -step + k2start; k2 <= step-k2end; k2 += 2 {
+			k2offset := offset + k2
+			var x int
+			if k2 == -step || (k2 != step && backward[k2offset-1] < backward[k2offset+1]) {
+				x = backward[k2offset+1]
+			} else {
+				x = backward[k2offset-1] + 1
+			}
+			y := x - k2
+			for x < n && y < m && d.eq(x1-x-1, y1-y-1) {
+				x, y = x+1, y+1
+			}
+			backward[k2offset] = x
+
+			if x > n {
+				k2end += 2
+			} else if y > m {
+				k2start += 2
+			} else if !odd {
+				k1offset := offset + delta - k2
+				if k1offset >= 0 && k1offset < length && forward[k1offset] != -1 {
+					fx := forward[k1offset]
+					fy := offset + fx - k1offset
+					if fx >= n-x {
+						return x0 + fx, y0 + fy, true
+					}
+				}
+			}
+		}
+	}
+
+	return 0, 0, false
+}
+
+func (d *differ[T, Offset]) delete(count int) {
+	d.x += count
+	d.deleted += count
+}
+
+func (d *differ[T, Offset]) insert(count int) {
+	d.y += count
+	d.inserted += count
+}
+
+func (d *differ[T, Offset]) equal(count int) {
+	if count == 0 {
+		return
+	}
+
+	d.flush()
+	old := d.from.Subview(Offset(d.x), Offset(d.x+count))
+	new := d.to.Subview(Offset(d.y), Offset(d.y+count))
+	d.x, d.y = d.x+count, d.y+count
+
+	if last := len(d.edits) - 1; last >= 0 && d.edits[last].Op == EditEqual {
+		d.edits[last].Old.End = old.End
+		d.edits[last].New.End = new.End
+		return
+	}
+
+	d.edits = append(d.edits, Edit[T, Offset]{Op: EditEqual, Old: old, New: new})
+}
+
+// Record the pending deleted and inserted items as edits.
+func (d *differ[T, Offset]) flush() {
+	if d.deleted > 0 {
+		d.edits = append(d.edits, Edit[T, Offset]{
+			Op:  EditDelete,
+			Old: d.from.Subview(Offset(d.x-d.deleted), Offset(d.x)),
+			New: d.to.Subview(Offset(d.y-d.inserted), Offset(d.y-d.inserted)),
+		})
+	}
+
+	if d.inserted > 0 {
+		d.edits = append(d.edits, Edit[T, Offset]{
+			Op:  EditInsert,
+			Old: d.from.Subview(Offset(d.x), Offset(d.x)),
+			New: d.to.Subview(Offset(d.y-d.inserted), Offset(d.y)),
+		})
+	}
+
+	d.deleted, d.inserted = 0, 0
+}
+
+// Formats the line diff of the provided text views in the unified diff
+// format, with the provided number of context lines around each change.
+// Returns an empty string if the texts are equal.
+//
+// A line includes its "\n" terminator, so a missing newline at the end of a
+// text is considered a change, and is marked as in GNU diff.
+func UnifiedDiff[T Char, Offset constraints.Unsigned](
+	fromName, toName string, from, to View[T, Offset], context int,
+) string {
+	fromLines := splitLinesAfter(from)
+	toLines := splitLinesAfter(to)
+
+	// Map each distinct line to an integer symbol, so lines can be compared
+	// in O(1) time by the diff algorithm.
+	symbols := NewViewMap[T, Offset, int]()
+	toSymbols := func(lines []View[T, Offset]) []int {
+		ids := make([]int, len(lines))
+		for i, line := range lines {
+			id, ok := symbols.Get(line)
+			if !ok {
+				id = symbols.Len()
+				symbols.Set(line, id)
+			}
+			ids[i] = id
+		}
+		return ids
+	}
+
+	edits := Diff(
+		BorrowView[int, uint](toSymbols(fromLines)),
+		BorrowView[int, uint](toSymbols(toLines)),
+	)
+	if len(edits) == 0 || (len(edits) == 1 && edits[0].Op == EditEqual) {
+		return ""
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "--- %s\n+++ %s\n", fromName, toName)
+
+	writeLine := func(prefix byte, line View[T, Offset]) {
+		builder.WriteByte(prefix)
+		writeText(&builder, line)
+		if back, err := line.Back(); err != nil || back != '\n' {
+			builder.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+
+	// Split the edit script into hunks, where each hunk contains the changes
+	// that are separated by at most 2*context equal lines.
+	for start := 0; start < len(edits); {
+		if edits[start].Op == EditEqual {
+			start++
+			continue
+		}
+
+		end := start
+		for end+1 < len(edits) {
+			next := edits[end+1]
+			if next.Op != EditEqual {
+				end++
+			} else if end+2 < len(edits) && int(next.Old.Len()) <= 2*context {
+				end += 2
+			} else {
+				break
+			}
+		}
+
+		leading := uint(0)
+		if start > 0 {
+			leading = min(uint(context), edits[start-1].Old.Len())
+		}
+		trailing := uint(0)
+		if end+1 < len(edits) {
+			trailing = min(uint(context), edits[end+1].Old.Len())
+		}
+
+		fromStart := edits[start].Old.Start - leading
+		toStart := edits[start].New.Start - leading
+		fromEnd := edits[end].Old.End + trailing
+		toEnd := edits[end].New.End + trailing
+
+		fmt.Fprintf(
+			&builder, "@@ -%s +%s @@\n",
+			unifiedRange(fromStart, fromEnd), unifiedRange(toStart, toEnd),
+		)
+
+		for i := fromStart; i < fromStart+leading; i++ {
+			writeLine(' ', fromLines[i])
+		}
+
+		for _, edit := range edits[start : end+1] {
+			switch edit.Op {
+			case EditEqual:
+				for i := edit.Old.Start; i < edit.Old.End; i++ {
+					writeLine(' ', fromLines[i])
+				}
+			case EditDelete:
+				for i := edit.Old.Start; i < edit.Old.End; i++ {
+					writeLine('-', fromLines[i])
+				}
+			case EditInsert:
+				for i := edit.New.Start; i < edit.New.End; i++ {
+					writeLine('+', toLines[i])
+				}
+			}
+		}
+
+		for i := fromEnd - trailing; i < fromEnd; i++ {
+			writeLine(' ', fromLines[i])
+		}
+
+		start = end + 1
+	}
+
+	return builder.String()
+}
+
+// Formats a line range of a unified diff hunk header, where start and end are
+// 0-based line indices.
+func unifiedRange(start, end uint) string {
+	switch end - start {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, end-start)
+	}
+}
+
+// Splits the provided text view into lines, where each line includes its "\n"
+// terminator, except possibly the last one.
+func splitLinesAfter[T Char, Offset constraints.Unsigned](v View[T, Offset]) []View[T, Offset] {
+	lines := make([]View[T, Offset], 0)
+	for v.Len() > 0 {
+		end := min(v.Index('\n')+1, v.Len())
+		line, rest := v.Partition(end)
+		lines = append(lines, line)
+		v = rest
+	}
+	return lines
+}
+
+// Writes the content of the provided text view.
+func writeText[T Char, Offset constraints.Unsigned](builder *strings.Builder, v View[T, Offset]) {
+	isByte := isByteChar[T]()
+	for item := range v.Range() {
+		if isByte {
+			builder.WriteByte(byte(item))
+		} else {
+			builder.WriteRune(rune(item))
+		}
+	}
+}
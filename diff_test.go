@@ -0,0 +1,176 @@
+package view_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func naiveLCS(a, b []int) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+	return table[0][0]
+}
+
+// Verifies that the edit script is valid, and returns its number of deleted
+// and inserted items.
+func checkEditScript(
+	t *testing.T, from, to view.View[int, uint], edits []view.Edit[int, uint],
+) int {
+	oldPos, newPos := from.Unmanaged().Start, to.Unmanaged().Start
+	changes := 0
+	for i, edit := range edits {
+		assert.Equal(t, oldPos, edit.Old.Start)
+		assert.Equal(t, newPos, edit.New.Start)
+		if i > 0 {
+			assert.NotEqual(t, edits[i-1].Op, edit.Op)
+		}
+
+		switch edit.Op {
+		case view.EditEqual:
+			assert.True(t, edit.Old.Attach(from.Ctx()).Equal(edit.New.Attach(to.Ctx())))
+		case view.EditDelete:
+			assert.EqualValues(t, 0, edit.New.Len())
+			changes += int(edit.Old.Len())
+		case view.EditInsert:
+			assert.EqualValues(t, 0, edit.Old.Len())
+			changes += int(edit.New.Len())
+		}
+
+		oldPos, newPos = edit.Old.End, edit.New.End
+	}
+	assert.Equal(t, from.Unmanaged().End, oldPos)
+	assert.Equal(t, to.Unmanaged().End, newPos)
+	return changes
+}
+
+func TestDiffSimpleCase(t *testing.T) {
+	from := view.NewView[rune, uint]([]rune("xxabcabba")).Subview(2, 9)
+	to := view.NewView[rune, uint]([]rune("cbabac"))
+	edits := view.Diff(from, to)
+
+	assert.EqualValues(t, 2, edits[0].Old.Start)
+	assert.Equal(t, view.EditDelete, edits[0].Op)
+	assert.Equal(t, "Insert", view.EditInsert.String())
+
+	changes := 0
+	for _, edit := range edits {
+		if edit.Op != view.EditEqual {
+			changes += int(edit.Old.Len() + edit.New.Len())
+		}
+	}
+	assert.Equal(t, 5, changes)
+}
+
+func TestDiffEmpty(t *testing.T) {
+	empty := view.NewView[int, uint](nil)
+	v := view.NewView[int, uint]([]int{1, 2})
+
+	assert.Empty(t, view.Diff(empty, empty))
+
+	edits := view.Diff(empty, v)
+	assert.Len(t, edits, 1)
+	assert.Equal(t, view.EditInsert, edits[0].Op)
+
+	edits = view.Diff(v, empty)
+	assert.Len(t, edits, 1)
+	assert.Equal(t, view.EditDelete, edits[0].Op)
+
+	edits = view.Diff(v, v)
+	assert.Len(t, edits, 1)
+	assert.Equal(t, view.EditEqual, edits[0].Op)
+}
+
+func TestDiffMatchesNaive(t *testing.T) {
+	random := rand.New(rand.NewPCG(3, 4))
+	for iteration := 0; iteration < 300; iteration++ {
+		a := make([]int, random.IntN(30))
+		b := make([]int, random.IntN(30))
+		alphabet := random.IntN(4) + 1
+		for i := range a {
+			a[i] = random.IntN(alphabet)
+		}
+		for i := range b {
+			b[i] = random.IntN(alphabet)
+		}
+
+		from := view.NewView[int, uint](append([]int{9}, a...)).Subview(1, uint(len(a)+1))
+		to := view.NewView[int, uint](b)
+		changes := checkEditScript(t, from, to, view.Diff(from, to))
+		assert.Equal(t, len(a)+len(b)-2*naiveLCS(a, b), changes)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	from := view.NewView[byte, uint]([]byte("a\nb\nc\nd\ne\nf\ng\nh\ni\n"))
+	to := view.NewView[byte, uint]([]byte("a\nB\nc\nd\ne\nf\ng\nh\ni\nj"))
+
+	expected := "" +
+		"--- old.txt\n" +
+		"+++ new.txt\n" +
+		"@@ -1,3 +1,3 @@\n" +
+		" a\n" +
+		"-b\n" +
+		"+B\n" +
+		" c\n" +
+		"@@ -9 +9,2 @@\n" +
+		" i\n" +
+		"+j\n" +
+		"\\ No newline at end of file\n"
+	assert.Equal(t, expected, view.UnifiedDiff("old.txt", "new.txt", from, to, 1))
+}
+
+func TestUnifiedDiffMergedHunks(t *testing.T) {
+	from := view.NewView[rune, uint8]([]rune("1\n2\n3\n4\n5\n"))
+	to := view.NewView[rune, uint8]([]rune("0\n1\n2\n4\n5\n"))
+
+	expected := "" +
+		"--- a\n" +
+		"+++ b\n" +
+		"@@ -0,0 +1 @@\n" +
+		"+0\n" +
+		"@@ -3 +3,0 @@\n" +
+		"-3\n"
+	assert.Equal(t, expected, view.UnifiedDiff("a", "b", from, to, 0))
+
+	expected = "" +
+		"--- a\n" +
+		"+++ b\n" +
+		"@@ -1,4 +1,4 @@\n" +
+		"+0\n" +
+		" 1\n" +
+		" 2\n" +
+		"-3\n" +
+		" 4\n"
+	assert.Equal(t, expected, view.UnifiedDiff("a", "b", from, to, 1))
+
+	expected = "" +
+		"--- a\n" +
+		"+++ b\n" +
+		"@@ -1,5 +1,5 @@\n" +
+		"+0\n" +
+		" 1\n" +
+		" 2\n" +
+		"-3\n" +
+		" 4\n" +
+		" 5\n"
+	assert.Equal(t, expected, view.UnifiedDiff("a", "b", from, to, 2))
+}
+
+func TestUnifiedDiffEqual(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("same\n"))
+	assert.Equal(t, "", view.UnifiedDiff("a", "b", v, v, 3))
+}
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Computes the edit distance between sequences of lengths n and m, where
+// eq(i, j) compares the i-th item of the first sequence with the j-th item of
+// the second sequence.
+//
+// Every insertion, deletion and substitution costs 1. If transpositions is
+// true, swapping two adjacent items also costs 1, under the restriction that
+// no item is edited more than once (the optimal string alignment distance).
+//
+// If bound is non-negative, only distances up to bound are computed exactly,
+// in O(bound * min(n, m)) time: if the distance is greater than bound, false
+// is returned as soon as it is known, with an undefined distance.
+func editDistance(n, m int, eq func(i, j int) bool, transpositions bool, bound int) (int, bool) {
+	if bound < 0 || bound > n+m {
+		bound = n + m
+	}
+
+	if n-m > bound || m-n > bound {
+		return 0, false
+	}
+
+	// Values greater than bound are all capped to inf, which keeps the cells
+	// outside of the computed band well defined.
+	inf := bound + 1
+	prev2 := make([]int, m+1)
+	prev := make([]int, m+1)
+	cur := make([]int, m+1)
+	for j := range prev {
+		prev[j] = min(j, inf)
+	}
+
+	for i := 1; i <= n; i++ {
+		lo, hi := max(1, i-bound), min(m, i+bound)
+
+		if lo > 1 {
+			cur[lo-1] = inf
+		} else {
+			cur[0] = min(i, inf)
+		}
+
+		rowMin := cur[lo-1]
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if eq(i-1, j-1) {
+				cost = 0
+			}
+
+			value := min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if transpositions && i > 1 && j > 1 && eq(i-1, j-2) && eq(i-2, j-1) {
+				value = min(value, prev2[j-2]+1)
+			}
+
+			cur[j] = min(value, inf)
+			rowMin = min(rowMin, cur[j])
+		}
+
+		if hi < m {
+			cur[hi+1] = inf
+		}
+
+		if rowMin > bound {
+			return 0, false
+		}
+
+		prev2, prev, cur = prev, cur, prev2
+	}
+
+	if prev[m] > bound {
+		return 0, false
+	}
+
+	return prev[m], true
+}
+
+// A candidate view, ranked by its edit distance to a query view.
+type Ranked[T comparable, Offset constraints.Unsigned] struct {
+	View     View[T, Offset]
+	Index    int
+	Distance int
+}
+
+// Ranks the provided candidate views by their Damerau-Levenshtein (optimal
+// string alignment) distance to the query view, from the closest to the
+// farthest. Candidates with equal distances keep their relative order. Index
+// is the index of the candidate in the provided slice.
+//
+// If maxDistance is non-negative, candidates that are farther than
+// maxDistance from the query are omitted, and their distances are not
+// computed in full. This makes ranking suitable for "did you mean"
+// suggestions over large sets of candidates.
+func RankByDistance[T comparable, Offset constraints.Unsigned](
+	query View[T, Offset], candidates []View[T, Offset], maxDistance int,
+) []Ranked[T, Offset] {
+	ranked := make([]Ranked[T, Offset], 0, len(candidates))
+	for idx, candidate := range candidates {
+		distance, ok := query.DamerauLevenshteinBounded(candidate, maxDistance)
+		if ok {
+			ranked = append(ranked, Ranked[T, Offset]{
+				View:     candidate,
+				Index:    idx,
+				Distance: distance,
+			})
+		}
+	}
+
+	slices.SortStableFunc(ranked, func(a, b Ranked[T, Offset]) int {
+		return a.Distance - b.Distance
+	})
+	return ranked
+}
@@ -0,0 +1,115 @@
+package view_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func naiveEditDistance(a, b []int, transpositions bool) int {
+	table := make([][]int, len(a)+1)
+	for i := range table {
+		table[i] = make([]int, len(b)+1)
+		table[i][0] = i
+	}
+	for j := range table[0] {
+		table[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			table[i][j] = min(table[i-1][j]+1, table[i][j-1]+1, table[i-1][j-1]+cost)
+			if transpositions && i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				table[i][j] = min(table[i][j], table[i-2][j-2]+1)
+			}
+		}
+	}
+	return table[len(a)][len(b)]
+}
+
+func TestLevenshteinSimpleCase(t *testing.T) {
+	a := view.NewView[rune, uint]([]rune("  kitten")).Subview(2, 8)
+	b := view.NewView[rune, uint]([]rune("sitting"))
+	assert.Equal(t, 3, a.Levenshtein(b))
+	assert.Equal(t, 3, b.Levenshtein(a))
+
+	_, ok := a.LevenshteinBounded(b, 2)
+	assert.False(t, ok)
+
+	distance, ok := a.LevenshteinBounded(b, 3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, distance)
+}
+
+func TestDamerauLevenshteinTransposition(t *testing.T) {
+	a := view.NewView[rune, uint]([]rune("recieve"))
+	b := view.NewView[rune, uint]([]rune("receive"))
+	assert.Equal(t, 2, a.Levenshtein(b))
+	assert.Equal(t, 1, a.DamerauLevenshtein(b))
+
+	// The optimal string alignment variant does not edit a substring twice.
+	assert.Equal(t, 3, view.NewView[rune, uint]([]rune("ca")).DamerauLevenshtein(
+		view.NewView[rune, uint]([]rune("abc")),
+	))
+}
+
+func TestEditDistanceMatchesNaive(t *testing.T) {
+	random := rand.New(rand.NewPCG(5, 6))
+	for iteration := 0; iteration < 500; iteration++ {
+		a := make([]int, random.IntN(12))
+		b := make([]int, random.IntN(12))
+		for i := range a {
+			a[i] = random.IntN(3)
+		}
+		for i := range b {
+			b[i] = random.IntN(3)
+		}
+
+		va, vb := view.NewView[int, uint](a), view.NewView[int, uint](b)
+		levenshtein := naiveEditDistance(a, b, false)
+		damerau := naiveEditDistance(a, b, true)
+		assert.Equal(t, levenshtein, va.Levenshtein(vb))
+		assert.Equal(t, damerau, va.DamerauLevenshtein(vb))
+
+		for bound := 0; bound <= 12; bound++ {
+			distance, ok := va.LevenshteinBounded(vb, bound)
+			assert.Equal(t, levenshtein <= bound, ok)
+			if ok {
+				assert.Equal(t, levenshtein, distance)
+			}
+
+			distance, ok = va.DamerauLevenshteinBounded(vb, bound)
+			assert.Equal(t, damerau <= bound, ok)
+			if ok {
+				assert.Equal(t, damerau, distance)
+			}
+		}
+	}
+}
+
+func TestRankByDistance(t *testing.T) {
+	words := []string{"println", "print", "sprintf", "printf", "panic"}
+	candidates := []view.View[rune, uint]{}
+	for _, word := range words {
+		candidates = append(candidates, view.NewView[rune, uint]([]rune(word)))
+	}
+
+	query := view.NewView[rune, uint]([]rune("pritnf"))
+	ranked := view.RankByDistance(query, candidates, 2)
+
+	got := []string{}
+	for _, candidate := range ranked {
+		got = append(got, string(candidate.View.Raw()))
+		assert.Equal(t, words[candidate.Index], string(candidate.View.Raw()))
+	}
+	assert.Equal(t, []string{"printf", "print", "sprintf"}, got)
+	assert.Equal(t, []int{1, 2, 2}, []int{ranked[0].Distance, ranked[1].Distance, ranked[2].Distance})
+
+	assert.Len(t, view.RankByDistance(query, candidates, -1), len(words))
+}
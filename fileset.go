@@ -0,0 +1,157 @@
+package view
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"slices"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A File is a named view context registered in a FileSet.
+// The file occupies the global offsets [Base(), Base()+Len()] of the set.
+type File[T comparable, Offset constraints.Unsigned] struct {
+	name string
+	base Offset
+	ctx  ViewContext[T]
+}
+
+// Returns the name of the file, as provided when it was added to the set.
+func (f *File[T, Offset]) Name() string {
+	return f.name
+}
+
+// Returns the global offset of the first item of the file.
+func (f *File[T, Offset]) Base() Offset {
+	return f.base
+}
+
+// Returns the context of the file.
+func (f *File[T, Offset]) Ctx() ViewContext[T] {
+	return f.ctx
+}
+
+// Returns the number of items in the file.
+func (f *File[T, Offset]) Len() Offset {
+	return Offset(len(f.ctx))
+}
+
+// Returns a (managed) view that spans over the whole file, in local offsets.
+func (f *File[T, Offset]) View() View[T, Offset] {
+	return UnmanagedView[T, Offset]{Start: 0, End: f.Len()}.Attach(f.ctx)
+}
+
+// Converts a view in the local offsets of the file into a view in the global
+// offsets of the file set.
+func (f *File[T, Offset]) Global(v UnmanagedView[T, Offset]) UnmanagedView[T, Offset] {
+	return UnmanagedView[T, Offset]{Start: f.base + v.Start, End: f.base + v.End}
+}
+
+// Converts a view in the global offsets of the file set into a view in the
+// local offsets of the file. Assumes that the provided view is contained in
+// the file.
+func (f *File[T, Offset]) Local(v UnmanagedView[T, Offset]) UnmanagedView[T, Offset] {
+	return UnmanagedView[T, Offset]{Start: v.Start - f.base, End: v.End - f.base}
+}
+
+// Returns true iff the provided global view is contained in the file.
+func (f *File[T, Offset]) Contains(v UnmanagedView[T, Offset]) bool {
+	return f.base <= v.Start && v.Start <= v.End && v.End <= f.base+f.Len()
+}
+
+// A FileSet assigns each of a collection of view contexts a disjoint range of
+// global offsets, similarly to go/token.FileSet.
+//
+// This allows storing bare unmanaged views that span over many contexts (for
+// example, the source files of a whole compilation unit), and resolving them
+// back to their file, context and local view when needed.
+//
+// A FileSet is safe to use concurrently.
+type FileSet[T comparable, Offset constraints.Unsigned] struct {
+	mutex sync.RWMutex
+	base  Offset
+	files []*File[T, Offset]
+}
+
+// Create a new, empty file set.
+func NewFileSet[T comparable, Offset constraints.Unsigned]() *FileSet[T, Offset] {
+	return &FileSet[T, Offset]{}
+}
+
+// Returns the base offset that will be assigned to the next added file.
+func (s *FileSet[T, Offset]) Base() Offset {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.base
+}
+
+// Register a new file in the set, and assign it the next available range of
+// global offsets. Consecutive files are separated by a single unused offset,
+// so a view that ends at the end of a file is never mistaken for a view in
+// the following file.
+//
+// Returns an error if the remaining global offsets (as limited by the Offset
+// type) are not enough to hold the provided context.
+func (s *FileSet[T, Offset]) AddFile(name string, ctx ViewContext[T]) (*File[T, Offset], error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	available := uint64(^Offset(0) - s.base)
+	if uint64(len(ctx)) >= available {
+		return nil, errors.New("file set global offsets exhausted")
+	}
+
+	file := &File[T, Offset]{name: name, base: s.base, ctx: ctx}
+	s.files = append(s.files, file)
+	s.base += Offset(len(ctx)) + 1
+	return file, nil
+}
+
+// Returns the file that contains the provided global view, or nil if there
+// is no such file, or if the view spans over more than a single file.
+func (s *FileSet[T, Offset]) File(v UnmanagedView[T, Offset]) *File[T, Offset] {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	idx, found := slices.BinarySearchFunc(
+		s.files, v.Start,
+		func(f *File[T, Offset], start Offset) int {
+			return cmp.Compare(f.base, start)
+		},
+	)
+
+	if !found {
+		idx--
+	}
+
+	if idx < 0 || !s.files[idx].Contains(v) {
+		return nil
+	}
+
+	return s.files[idx]
+}
+
+// Resolve the provided global view into its file, and a view over the file
+// context in local offsets.
+//
+// Returns an error if no file in the set contains the provided view.
+func (s *FileSet[T, Offset]) Resolve(v UnmanagedView[T, Offset]) (
+	*File[T, Offset], View[T, Offset], error,
+) {
+	file := s.File(v)
+	if file == nil {
+		return nil, View[T, Offset]{}, errors.New("view is not contained in any file")
+	}
+
+	return file, file.Local(v).Attach(file.ctx), nil
+}
+
+// Iterate over all files in the set, in the order they were added (rangefunc).
+func (s *FileSet[T, Offset]) Files() iter.Seq[*File[T, Offset]] {
+	s.mutex.RLock()
+	files := s.files[:len(s.files):len(s.files)]
+	s.mutex.RUnlock()
+	return slices.Values(files)
+}
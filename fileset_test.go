@@ -0,0 +1,69 @@
+package view_test
+
+import (
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSetResolve(t *testing.T) {
+	set := view.NewFileSet[rune, uint]()
+	foo, err := set.AddFile("foo.txt", view.NewView[rune, uint]([]rune("hello")).Ctx())
+	assert.NoError(t, err)
+	bar, err := set.AddFile("bar.txt", view.NewView[rune, uint]([]rune("world!")).Ctx())
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, 0, foo.Base())
+	assert.EqualValues(t, 6, bar.Base())
+	assert.EqualValues(t, 13, set.Base())
+
+	global := bar.Global(bar.View().Subview(1, 4).Unmanaged())
+	assert.Equal(t, view.UnmanagedView[rune, uint]{Start: 7, End: 10}, global)
+
+	file, local, err := set.Resolve(global)
+	assert.NoError(t, err)
+	assert.Equal(t, "bar.txt", file.Name())
+	assert.Equal(t, []rune("orl"), local.Raw())
+}
+
+func TestFileSetEndOfFile(t *testing.T) {
+	set := view.NewFileSet[int, uint]()
+	a, _ := set.AddFile("a", view.ViewContext[int]{1, 2, 3})
+	b, _ := set.AddFile("b", view.ViewContext[int]{4, 5})
+
+	eof := view.UnmanagedView[int, uint]{Start: 3, End: 3}
+	assert.Equal(t, a, set.File(eof))
+
+	bof := view.UnmanagedView[int, uint]{Start: 4, End: 4}
+	assert.Equal(t, b, set.File(bof))
+}
+
+func TestFileSetNotContained(t *testing.T) {
+	set := view.NewFileSet[int, uint]()
+	set.AddFile("a", view.ViewContext[int]{1, 2, 3})
+	set.AddFile("b", view.ViewContext[int]{4, 5})
+
+	crossing := view.UnmanagedView[int, uint]{Start: 2, End: 5}
+	assert.Nil(t, set.File(crossing))
+
+	_, _, err := set.Resolve(crossing)
+	assert.Error(t, err)
+
+	past := view.UnmanagedView[int, uint]{Start: 7, End: 8}
+	assert.Nil(t, set.File(past))
+}
+
+func TestFileSetExhausted(t *testing.T) {
+	set := view.NewFileSet[byte, uint8]()
+	_, err := set.AddFile("a", make(view.ViewContext[byte], 200))
+	assert.NoError(t, err)
+	_, err = set.AddFile("b", make(view.ViewContext[byte], 100))
+	assert.Error(t, err)
+
+	names := []string{}
+	for file := range set.Files() {
+		names = append(names, file.Name())
+	}
+	assert.Equal(t, []string{"a"}, names)
+}
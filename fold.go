@@ -0,0 +1,157 @@
+package view
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Case insensitive comparison and search over text views, under Unicode
+// simple case folding, with the same semantics as strings.EqualFold.
+//
+// Rune views are compared rune by rune. Byte views are treated as UTF-8
+// encoded text, and are decoded on the fly; invalid UTF-8 sequences are
+// decoded as utf8.RuneError, one byte at a time.
+
+// Returns true iff the provided views are equal under simple case folding.
+// Each view is followed by its context.
+func EqualFoldUnmanaged[T Char, Offset constraints.Unsigned](
+	v UnmanagedView[T, Offset], vctx ViewContext[T],
+	u UnmanagedView[T, Offset], uctx ViewContext[T],
+) bool {
+	i, j := Offset(0), Offset(0)
+	for i < v.Len() && j < u.Len() {
+		vr, vw := decodeChar(v, vctx, i)
+		ur, uw := decodeChar(u, uctx, j)
+		if !equalFoldRune(vr, ur) {
+			return false
+		}
+		i, j = i+vw, j+uw
+	}
+
+	return i == v.Len() && j == u.Len()
+}
+
+// Returns true iff the provided views are equal under simple case folding,
+// similarly to strings.EqualFold.
+func EqualFold[T Char, Offset constraints.Unsigned](v, u View[T, Offset]) bool {
+	return EqualFoldUnmanaged(v.unmanaged, v.ctx, u.unmanaged, u.ctx)
+}
+
+// Returns true iff the provided prefix view is a prefix of the view v under
+// simple case folding. Each view is followed by its context.
+func HasPrefixFoldUnmanaged[T Char, Offset constraints.Unsigned](
+	v UnmanagedView[T, Offset], vctx ViewContext[T],
+	prefix UnmanagedView[T, Offset], prefixCtx ViewContext[T],
+) bool {
+	i, j := Offset(0), Offset(0)
+	for j < prefix.Len() {
+		if i >= v.Len() {
+			return false
+		}
+
+		vr, vw := decodeChar(v, vctx, i)
+		pr, pw := decodeChar(prefix, prefixCtx, j)
+		if !equalFoldRune(vr, pr) {
+			return false
+		}
+		i, j = i+vw, j+pw
+	}
+
+	return true
+}
+
+// Returns true iff the provided prefix view is a prefix of the view v under
+// simple case folding.
+func HasPrefixFold[T Char, Offset constraints.Unsigned](v, prefix View[T, Offset]) bool {
+	return HasPrefixFoldUnmanaged(v.unmanaged, v.ctx, prefix.unmanaged, prefix.ctx)
+}
+
+// Find the first occurrence of the needle view in the view v under simple
+// case folding. Each view is followed by its context.
+// Return the index of the start of the occurrence (relative to the view start
+// offset), or v.Len() if the needle does not appear in the view.
+//
+// The search does not allocate. It tries to match the needle at every rune
+// boundary of the view, so it runs in O(n*m) time in the worst case, where n
+// and m are the lengths of the view and the needle.
+func IndexFoldUnmanaged[T Char, Offset constraints.Unsigned](
+	v UnmanagedView[T, Offset], vctx ViewContext[T],
+	needle UnmanagedView[T, Offset], needleCtx ViewContext[T],
+) Offset {
+	if needle.Len() == 0 {
+		return 0
+	}
+
+	for i := Offset(0); i < v.Len(); {
+		if HasPrefixFoldUnmanaged(v.Subview(i, v.Len()), vctx, needle, needleCtx) {
+			return i
+		}
+
+		_, w := decodeChar(v, vctx, i)
+		i += w
+	}
+
+	return v.Len()
+}
+
+// Find the first occurrence of the needle view in the view v under simple
+// case folding. Return the index of the start of the occurrence (relative to
+// the view start offset), or v.Len() if the needle does not appear in the view.
+//
+// See IndexFoldUnmanaged for more details.
+func IndexFold[T Char, Offset constraints.Unsigned](v, needle View[T, Offset]) Offset {
+	return IndexFoldUnmanaged(v.unmanaged, v.ctx, needle.unmanaged, needle.ctx)
+}
+
+// Decode the character that starts at the provided index of the view, and
+// return it together with the number of items it spans.
+func decodeChar[T Char, Offset constraints.Unsigned](
+	v UnmanagedView[T, Offset], ctx ViewContext[T], index Offset,
+) (rune, Offset) {
+	first := v.AtUnsafe(ctx, index)
+	if !isByteChar[T]() {
+		return rune(first), 1
+	}
+
+	if first < utf8.RuneSelf {
+		return rune(first), 1
+	}
+
+	var buf [utf8.UTFMax]byte
+	n := Offset(0)
+	for n < utf8.UTFMax && n < v.Len()-index {
+		buf[n] = byte(v.AtUnsafe(ctx, index+n))
+		n++
+	}
+
+	r, size := utf8.DecodeRune(buf[:n])
+	return r, Offset(size)
+}
+
+// Returns true iff the provided runes are equal under simple case folding.
+// This is the inner comparison of strings.EqualFold.
+func equalFoldRune(sr, tr rune) bool {
+	if sr == tr {
+		return true
+	}
+
+	// Make sr < tr to simplify what follows.
+	if tr < sr {
+		tr, sr = sr, tr
+	}
+
+	// Fast check for ASCII.
+	if tr < utf8.RuneSelf {
+		return 'A' <= sr && sr <= 'Z' && tr == sr+'a'-'A'
+	}
+
+	// General case: SimpleFold(x) returns the next equivalent rune > x or
+	// wraps around to smaller values.
+	r := unicode.SimpleFold(sr)
+	for r != sr && r < tr {
+		r = unicode.SimpleFold(r)
+	}
+	return r == tr
+}
@@ -0,0 +1,79 @@
+package view_test
+
+import (
+	"strings"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqualFoldRunes(t *testing.T) {
+	a := view.NewView[rune, uint]([]rune("  MOV")).Subview(2, 5)
+	b := view.NewView[rune, uint]([]rune("mov"))
+	assert.True(t, view.EqualFold(a, b))
+	assert.False(t, view.EqualFold(a, b.Subview(0, 2)))
+}
+
+func TestEqualFoldMatchesStrings(t *testing.T) {
+	pairs := [][2]string{
+		{"Go", "GO"},
+		{"σς", "ΣΣ"},
+		{"\u212a", "k"},
+		{"straße", "STRASSE"},
+		{"abc", "abd"},
+		{"\xff", "\xfe"},
+		{"ǅ", "ǆ"},
+		{"", ""},
+		{"a", ""},
+	}
+
+	for _, pair := range pairs {
+		expected := strings.EqualFold(pair[0], pair[1])
+
+		a := view.NewView[byte, uint]([]byte(pair[0]))
+		b := view.NewView[byte, uint]([]byte(pair[1]))
+		assert.Equal(t, expected, view.EqualFold(a, b), pair)
+
+		ar := view.NewView[rune, uint]([]rune(pair[0]))
+		br := view.NewView[rune, uint]([]rune(pair[1]))
+		assert.Equal(t, expected, view.EqualFold(ar, br), pair)
+	}
+}
+
+func TestHasPrefixFold(t *testing.T) {
+	v := view.NewView[byte, uint32]([]byte(".GLOBL main"))
+	assert.True(t, view.HasPrefixFold(v, view.NewView[byte, uint32]([]byte(".globl"))))
+	assert.False(t, view.HasPrefixFold(v, view.NewView[byte, uint32]([]byte(".global"))))
+	assert.False(t, view.HasPrefixFold(v.Subview(0, 3), v))
+}
+
+func TestIndexFold(t *testing.T) {
+	v := view.NewView[byte, uint]([]byte("xx ÉCOLE école"))
+	needle := view.NewView[byte, uint]([]byte("éco"))
+	assert.EqualValues(t, 3, view.IndexFold(v, needle))
+	assert.EqualValues(t, 3, view.IndexFold(v.Subview(3, v.Len()), needle.Subview(3, 4)))
+	assert.EqualValues(t, v.Len(), view.IndexFold(v, view.NewView[byte, uint]([]byte("écl"))))
+	assert.EqualValues(t, 0, view.IndexFold(v, view.NewView[byte, uint](nil)))
+}
+
+func TestIndexFoldRunes(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("aaAAaB"))
+	assert.EqualValues(t, 3, view.IndexFold(v, view.NewView[rune, uint]([]rune("AAb"))))
+}
+
+func TestIndexFoldMixedWidths(t *testing.T) {
+	v := view.NewView[byte, uint]([]byte("ab\u212ac"))
+	assert.EqualValues(t, 2, view.IndexFold(v, view.NewView[byte, uint]([]byte("KC"))))
+}
+
+func TestFoldDoesNotAllocate(t *testing.T) {
+	v := view.NewView[byte, uint]([]byte("xx ÉCOLE école K"))
+	needle := view.NewView[byte, uint]([]byte("ÉCOLE K"))
+	allocs := testing.AllocsPerRun(10, func() {
+		view.EqualFold(v, needle)
+		view.HasPrefixFold(v, needle)
+		view.IndexFold(v, needle)
+	})
+	assert.Equal(t, float64(0), allocs)
+}
@@ -1,6 +1,6 @@
 module alon.kr/x/view
 
-go 1.23
+go 1.24
 
 require (
 	github.com/stretchr/testify v1.9.0
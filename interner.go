@@ -0,0 +1,131 @@
+package view
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// An Interner maps views to small integer symbol ids by their content, and
+// stores a single canonical copy of the content of each distinct view.
+//
+// Symbol ids are assigned consecutively, starting from 0, in the order the
+// distinct views are first interned. The canonical copies are stored in a
+// context owned by the interner, which grows as new views are interned.
+//
+// An Interner must be created with NewInterner, and is safe to use
+// concurrently.
+type Interner[T comparable, Offset constraints.Unsigned] struct {
+	mutex   sync.RWMutex
+	seed    maphash.Seed
+	ctx     ViewContext[T]
+	views   []UnmanagedView[T, Offset]
+	buckets map[uint64][]int
+}
+
+// Create a new, empty interner.
+func NewInterner[T comparable, Offset constraints.Unsigned]() *Interner[T, Offset] {
+	return &Interner[T, Offset]{
+		seed:    maphash.MakeSeed(),
+		buckets: make(map[uint64][]int),
+	}
+}
+
+// Returns the number of distinct symbols in the interner.
+func (i *Interner[T, Offset]) Len() int {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return len(i.views)
+}
+
+// Returns the context that holds the canonical copies of all interned views.
+//
+// The interner only appends to its context, so a returned context stays valid
+// for all canonical views that were interned before it was returned.
+func (i *Interner[T, Offset]) Ctx() ViewContext[T] {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return i.ctx
+}
+
+// Returns the symbol id of the view with content equal to the provided view,
+// and true, or an undefined value and false if no such view was interned.
+func (i *Interner[T, Offset]) Lookup(v View[T, Offset]) (int, bool) {
+	hash := v.Hash(i.seed)
+
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return i.lookup(v, hash)
+}
+
+// Intern the provided view: returns the symbol id of its content, and the
+// canonical view of the content in the context of the interner.
+// If the content was not yet interned, it is copied into the interner and
+// assigned a new symbol id.
+//
+// Returns an error if the interner context would grow beyond the maximal
+// value of the Offset type.
+func (i *Interner[T, Offset]) Intern(v View[T, Offset]) (int, UnmanagedView[T, Offset], error) {
+	hash := v.Hash(i.seed)
+
+	i.mutex.RLock()
+	id, found := i.lookup(v, hash)
+	if found {
+		canonical := i.views[id]
+		i.mutex.RUnlock()
+		return id, canonical, nil
+	}
+	i.mutex.RUnlock()
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	// The view might have been interned between releasing the read lock and
+	// acquiring the write lock.
+	if id, found := i.lookup(v, hash); found {
+		return id, i.views[id], nil
+	}
+
+	if err := checkLength[Offset](len(i.ctx) + int(v.Len())); err != nil {
+		return 0, UnmanagedView[T, Offset]{}, err
+	}
+
+	canonical := UnmanagedView[T, Offset]{
+		Start: Offset(len(i.ctx)),
+		End:   Offset(len(i.ctx)) + v.Len(),
+	}
+
+	i.ctx = append(i.ctx, v.Raw()...)
+	id = len(i.views)
+	i.views = append(i.views, canonical)
+	i.buckets[hash] = append(i.buckets[hash], id)
+	return id, canonical, nil
+}
+
+// Returns the canonical unmanaged view of the provided symbol id.
+// The function panics if the id was not returned by the interner.
+func (i *Interner[T, Offset]) Unmanaged(id int) UnmanagedView[T, Offset] {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return i.views[id]
+}
+
+// Returns the canonical view of the provided symbol id, attached to the
+// context of the interner.
+// The function panics if the id was not returned by the interner.
+func (i *Interner[T, Offset]) View(id int) View[T, Offset] {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	return i.views[id].Attach(i.ctx)
+}
+
+// Must be called while holding the lock, for reading or writing.
+func (i *Interner[T, Offset]) lookup(v View[T, Offset], hash uint64) (int, bool) {
+	for _, id := range i.buckets[hash] {
+		if i.views[id].Equal(i.ctx, v.unmanaged, v.ctx) {
+			return id, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,99 @@
+package view_test
+
+import (
+	"sync"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInternerSimpleCase(t *testing.T) {
+	interner := view.NewInterner[rune, uint]()
+	source := view.NewView[rune, uint]([]rune("foo bar foo"))
+
+	foo, canonical, err := interner.Intern(source.Subview(0, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, foo)
+	assert.Equal(t, []rune("foo"), canonical.Raw(interner.Ctx()))
+
+	bar, _, err := interner.Intern(source.Subview(4, 7))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, bar)
+
+	again, canonicalAgain, err := interner.Intern(source.Subview(8, 11))
+	assert.NoError(t, err)
+	assert.Equal(t, foo, again)
+	assert.Equal(t, canonical, canonicalAgain)
+
+	assert.Equal(t, 2, interner.Len())
+	assert.Equal(t, []rune("bar"), interner.View(bar).Raw())
+	assert.Equal(t, canonical, interner.Unmanaged(foo))
+}
+
+func TestInternerCopiesContent(t *testing.T) {
+	interner := view.NewInterner[int, uint]()
+	data := []int{1, 2, 3}
+
+	id, _, err := interner.Intern(view.BorrowView[int, uint](data))
+	assert.NoError(t, err)
+	data[0] = 7
+
+	assert.Equal(t, []int{1, 2, 3}, interner.View(id).Raw())
+}
+
+func TestInternerLookup(t *testing.T) {
+	interner := view.NewInterner[int, uint]()
+	_, _, err := interner.Intern(view.NewView[int, uint]([]int{4, 5}))
+	assert.NoError(t, err)
+
+	id, ok := interner.Lookup(view.NewView[int, uint]([]int{4, 5}))
+	assert.True(t, ok)
+	assert.Equal(t, 0, id)
+
+	_, ok = interner.Lookup(view.NewView[int, uint]([]int{4}))
+	assert.False(t, ok)
+	assert.Equal(t, 1, interner.Len())
+}
+
+func TestInternerOffsetOverflow(t *testing.T) {
+	interner := view.NewInterner[byte, uint8]()
+	_, _, err := interner.Intern(view.NewView[byte, uint8](make([]byte, 200)))
+	assert.NoError(t, err)
+
+	_, _, err = interner.Intern(view.NewView[byte, uint8]([]byte("x")))
+	assert.NoError(t, err)
+
+	big := make([]byte, 100)
+	big[0] = 1
+	_, _, err = interner.Intern(view.NewView[byte, uint8](big))
+	assert.Error(t, err)
+}
+
+func TestInternerConcurrent(t *testing.T) {
+	interner := view.NewInterner[rune, uint]()
+	words := []string{"alpha", "beta", "gamma", "delta", "alpha", "beta"}
+
+	var wg sync.WaitGroup
+	ids := make([][]int, 8)
+	for worker := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, word := range words {
+				id, _, err := interner.Intern(view.NewView[rune, uint]([]rune(word)))
+				assert.NoError(t, err)
+				ids[worker] = append(ids[worker], id)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 4, interner.Len())
+	for _, workerIds := range ids {
+		assert.Equal(t, ids[0], workerIds)
+		for i, id := range workerIds {
+			assert.Equal(t, []rune(words[i]), interner.View(id).Raw())
+		}
+	}
+}
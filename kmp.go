@@ -0,0 +1,93 @@
+package view
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// An implementation of the Knuth-Morris-Pratt string matching algorithm,
+// which finds a needle of length m in a haystack of length n in O(n+m) time
+// and O(m) additional space.
+//
+// The sequences are provided as accessor functions rather than views, so the
+// same implementation can be used to scan both forward and backward.
+// Items are compared using the provided eq function, which must be an
+// equivalence relation.
+
+// Computes the KMP failure table of the provided sequence, where the i-th
+// entry is the length of the longest proper prefix of seq[:i+1] that is also
+// a suffix of it.
+func kmpFailureTable[T comparable, Offset constraints.Unsigned](
+	n Offset, at func(Offset) T, eq func(T, T) bool,
+) []Offset {
+	table := make([]Offset, n)
+	k := Offset(0)
+	for i := Offset(1); i < n; i++ {
+		cur := at(i)
+		for k > 0 && !eq(cur, at(k)) {
+			k = table[k-1]
+		}
+		if eq(cur, at(k)) {
+			k++
+		}
+		table[i] = k
+	}
+	return table
+}
+
+// Returns the index of the first occurrence of the needle in the haystack,
+// and true, or an undefined value and false if no such occurrence exists.
+func kmpIndex[T comparable, Offset constraints.Unsigned](
+	hayLen Offset, hay func(Offset) T,
+	needleLen Offset, needle func(Offset) T,
+	eq func(T, T) bool,
+) (Offset, bool) {
+	if needleLen == 0 {
+		return 0, true
+	}
+
+	if needleLen > hayLen {
+		return 0, false
+	}
+
+	table := kmpFailureTable(needleLen, needle, eq)
+	return kmpIndexFrom(table, hayLen, hay, 0, needleLen, needle, eq)
+}
+
+// Returns the index of the first occurrence of the non-empty needle in the
+// haystack that starts at or after the provided index, and true, or an
+// undefined value and false if no such occurrence exists.
+//
+// The failure table of the needle is provided by the caller, so it can be
+// reused across multiple searches of the same needle.
+func kmpIndexFrom[T comparable, Offset constraints.Unsigned](
+	table []Offset,
+	hayLen Offset, hay func(Offset) T, from Offset,
+	needleLen Offset, needle func(Offset) T,
+	eq func(T, T) bool,
+) (Offset, bool) {
+	if from > hayLen || needleLen > hayLen-from {
+		return 0, false
+	}
+
+	k := Offset(0)
+	for i := from; i < hayLen; i++ {
+		cur := hay(i)
+		for k > 0 && !eq(cur, needle(k)) {
+			k = table[k-1]
+		}
+		if eq(cur, needle(k)) {
+			k++
+		}
+		if k == needleLen {
+			return i + 1 - needleLen, true
+		}
+	}
+
+	return 0, false
+}
+
+// The default equality function, used by all search functions that compare
+// items with ==.
+func equal[T comparable](a, b T) bool {
+	return a == b
+}
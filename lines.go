@@ -0,0 +1,210 @@
+package view
+
+import (
+	"slices"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Element types of views over source text: runes, or bytes of UTF-8 encoded
+// text.
+type Char interface {
+	~byte | ~rune
+}
+
+// A human readable position in source text.
+// Both the line and the column are 1-based.
+type Position struct {
+	Line, Column int
+}
+
+// A LineIndex maps offsets in a view context over source text into line and
+// column positions.
+//
+// Lines are terminated by "\n", "\r\n", or a lone "\r". Columns count
+// characters: for byte contexts, UTF-8 continuation bytes do not advance the
+// column. A tab advances the column to the next tab stop.
+//
+// The index is built once per context in O(n) time, and afterwards each
+// query is answered in O(log n) time. A LineIndex is immutable after
+// construction, and is safe to use concurrently.
+type LineIndex[T Char, Offset constraints.Unsigned] struct {
+	// The offset of the first item of each line.
+	lineStarts []Offset
+
+	// The offset just past the content of each line, excluding the line
+	// terminator.
+	lineEnds []Offset
+
+	// The offsets of all tab characters in the context, and for each tab, the
+	// column of the character right after it.
+	tabs       []Offset
+	tabColumns []int
+
+	// The offsets of all UTF-8 continuation bytes in the context.
+	// Always empty for non-byte contexts.
+	continuations []Offset
+
+	tabWidth int
+	len      Offset
+}
+
+// Returns true iff T is a byte type, in which case the context is treated as
+// UTF-8 encoded text.
+func isByteChar[T Char]() bool {
+	var zero T
+	return unsafe.Sizeof(zero) == 1
+}
+
+// Returns true iff the provided item of a byte context is a UTF-8
+// continuation byte, which does not start a new character.
+func isContinuationByte[T Char](item T) bool {
+	return item&0xC0 == 0x80
+}
+
+// Returns the column that follows a tab character at the provided column.
+func nextTabStop(column, tabWidth int) int {
+	return ((column-1)/tabWidth+1)*tabWidth + 1
+}
+
+// Build a new line index over the provided context.
+// Tab stops are placed every tabWidth columns. A tab width smaller than 1 is
+// treated as 1, in which case a tab advances the column like any other
+// character.
+//
+// Returns an error if the context is longer than the maximal Offset value.
+func NewLineIndex[T Char, Offset constraints.Unsigned](
+	ctx ViewContext[T], tabWidth int,
+) (*LineIndex[T, Offset], error) {
+	if err := checkLength[Offset](len(ctx)); err != nil {
+		return nil, err
+	}
+
+	tabWidth = max(tabWidth, 1)
+
+	isByte := isByteChar[T]()
+
+	n := Offset(len(ctx))
+	index := &LineIndex[T, Offset]{
+		lineStarts: []Offset{0},
+		tabWidth:   tabWidth,
+		len:        n,
+	}
+
+	column := 1
+	for i := Offset(0); i < n; i++ {
+		switch item := ctx[i]; {
+		case item == '\n':
+			index.lineEnds = append(index.lineEnds, i)
+			index.lineStarts = append(index.lineStarts, i+1)
+			column = 1
+
+		case item == '\r':
+			if i+1 < n && ctx[i+1] == '\n' {
+				index.lineEnds = append(index.lineEnds, i)
+				index.lineStarts = append(index.lineStarts, i+2)
+				i++
+			} else {
+				index.lineEnds = append(index.lineEnds, i)
+				index.lineStarts = append(index.lineStarts, i+1)
+			}
+			column = 1
+
+		case item == '\t':
+			column = nextTabStop(column, tabWidth)
+			index.tabs = append(index.tabs, i)
+			index.tabColumns = append(index.tabColumns, column)
+
+		case isByte && isContinuationByte(item):
+			index.continuations = append(index.continuations, i)
+
+		default:
+			column++
+		}
+	}
+
+	index.lineEnds = append(index.lineEnds, n)
+	return index, nil
+}
+
+// Returns the tab width that the index was built with.
+func (l *LineIndex[T, Offset]) TabWidth() int {
+	return l.tabWidth
+}
+
+// Returns the number of lines in the context.
+// An empty context, or a context that ends with a line terminator, is
+// considered to have an additional empty last line.
+func (l *LineIndex[T, Offset]) LineCount() int {
+	return len(l.lineStarts)
+}
+
+// Returns the view of the provided line (1-based), excluding its terminator.
+// If the line number is out of range, an empty view at the nearest end of the
+// context is returned.
+func (l *LineIndex[T, Offset]) Line(line int) UnmanagedView[T, Offset] {
+	if line < 1 {
+		return UnmanagedView[T, Offset]{Start: 0, End: 0}
+	}
+
+	if line > len(l.lineStarts) {
+		return UnmanagedView[T, Offset]{Start: l.len, End: l.len}
+	}
+
+	return UnmanagedView[T, Offset]{
+		Start: l.lineStarts[line-1],
+		End:   l.lineEnds[line-1],
+	}
+}
+
+// Returns the line (1-based) that contains the provided offset.
+// Offsets past the end of the context are clamped to the context length.
+func (l *LineIndex[T, Offset]) LineOf(offset Offset) int {
+	offset = min(offset, l.len)
+	idx, found := slices.BinarySearch(l.lineStarts, offset)
+	if !found {
+		idx--
+	}
+	return idx + 1
+}
+
+// Returns the position of the provided offset.
+// Offsets past the end of the context are clamped to the context length.
+func (l *LineIndex[T, Offset]) Position(offset Offset) Position {
+	offset = min(offset, l.len)
+	line := l.LineOf(offset)
+
+	from := l.lineStarts[line-1]
+	column := 1
+
+	tab, _ := slices.BinarySearch(l.tabs, offset)
+	if tab > 0 && l.tabs[tab-1] >= from {
+		from = l.tabs[tab-1] + 1
+		column = l.tabColumns[tab-1]
+	}
+
+	return Position{
+		Line:   line,
+		Column: column + l.countChars(from, offset),
+	}
+}
+
+// Returns the positions of the start and the end of the provided view.
+// The end position is exclusive: it is the position of the item right after
+// the last item in the view.
+func (l *LineIndex[T, Offset]) Span(v UnmanagedView[T, Offset]) (Position, Position) {
+	return l.Position(v.Start), l.Position(v.End)
+}
+
+// Returns the number of characters in the range [from, to), assuming that the
+// range does not contain line terminators or tabs.
+func (l *LineIndex[T, Offset]) countChars(from, to Offset) int {
+	if from >= to {
+		return 0
+	}
+
+	lo, _ := slices.BinarySearch(l.continuations, from)
+	hi, _ := slices.BinarySearch(l.continuations, to)
+	return int(to-from) - (hi - lo)
+}
@@ -0,0 +1,95 @@
+package view_test
+
+import (
+	"strings"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLineIndexSimpleCase(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("foo\nbar baz\n\nqux"))
+	index, err := view.NewLineIndex[rune, uint](v.Ctx(), 4)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, index.LineCount())
+	assert.Equal(t, view.Position{Line: 1, Column: 1}, index.Position(0))
+	assert.Equal(t, view.Position{Line: 1, Column: 4}, index.Position(3))
+	assert.Equal(t, view.Position{Line: 2, Column: 1}, index.Position(4))
+	assert.Equal(t, view.Position{Line: 2, Column: 5}, index.Position(8))
+	assert.Equal(t, view.Position{Line: 3, Column: 1}, index.Position(12))
+	assert.Equal(t, view.Position{Line: 4, Column: 4}, index.Position(16))
+	assert.Equal(t, view.Position{Line: 4, Column: 4}, index.Position(100))
+}
+
+func TestLineIndexSpan(t *testing.T) {
+	v := view.NewView[byte, uint32]([]byte("foo\nbar baz\n"))
+	index, err := view.NewLineIndex[byte, uint32](v.Ctx(), 4)
+	assert.NoError(t, err)
+
+	start, end := index.Span(v.Subview(2, 7).Unmanaged())
+	assert.Equal(t, view.Position{Line: 1, Column: 3}, start)
+	assert.Equal(t, view.Position{Line: 2, Column: 4}, end)
+}
+
+func TestLineIndexCarriageReturn(t *testing.T) {
+	v := view.NewView[byte, uint]([]byte("a\r\nbc\rd"))
+	index, err := view.NewLineIndex[byte, uint](v.Ctx(), 4)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 3, index.LineCount())
+	assert.Equal(t, view.Position{Line: 2, Column: 1}, index.Position(3))
+	assert.Equal(t, view.Position{Line: 3, Column: 1}, index.Position(6))
+	assert.Equal(t, []byte("a"), index.Line(1).Raw(v.Ctx()))
+	assert.Equal(t, []byte("bc"), index.Line(2).Raw(v.Ctx()))
+	assert.Equal(t, []byte("d"), index.Line(3).Raw(v.Ctx()))
+}
+
+func TestLineIndexTabs(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("\tx\n ab\tc\t\td"))
+	index, err := view.NewLineIndex[rune, uint](v.Ctx(), 4)
+	assert.NoError(t, err)
+
+	assert.Equal(t, view.Position{Line: 1, Column: 5}, index.Position(1))
+	assert.Equal(t, view.Position{Line: 2, Column: 4}, index.Position(6))
+	assert.Equal(t, view.Position{Line: 2, Column: 5}, index.Position(7))
+	assert.Equal(t, view.Position{Line: 2, Column: 13}, index.Position(10))
+}
+
+func TestLineIndexTabWidthOne(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("\t\tx"))
+	index, err := view.NewLineIndex[rune, uint](v.Ctx(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, view.Position{Line: 1, Column: 3}, index.Position(2))
+}
+
+func TestLineIndexUTF8(t *testing.T) {
+	v := view.NewView[byte, uint]([]byte("héllo\twörld"))
+	index, err := view.NewLineIndex[byte, uint](v.Ctx(), 8)
+	assert.NoError(t, err)
+
+	assert.Equal(t, view.Position{Line: 1, Column: 3}, index.Position(3))
+	assert.Equal(t, view.Position{Line: 1, Column: 6}, index.Position(6))
+	assert.Equal(t, view.Position{Line: 1, Column: 9}, index.Position(7))
+	assert.Equal(t, view.Position{Line: 1, Column: 11}, index.Position(10))
+}
+
+func TestLineIndexOutOfRangeLine(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("ab\ncd"))
+	index, err := view.NewLineIndex[rune, uint](v.Ctx(), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, view.UnmanagedView[rune, uint]{Start: 0, End: 0}, index.Line(0))
+	assert.Equal(t, view.UnmanagedView[rune, uint]{Start: 5, End: 5}, index.Line(3))
+}
+
+func TestLineIndexContextTooLong(t *testing.T) {
+	ctx := view.ViewContext[rune]([]rune(strings.Repeat("a", 300)))
+	index, err := view.NewLineIndex[rune, uint8](ctx, 4)
+	assert.Error(t, err)
+	assert.Nil(t, index)
+
+	index, err = view.NewLineIndex[rune, uint8](ctx[:255], 4)
+	assert.NoError(t, err)
+	assert.Equal(t, view.Position{Line: 1, Column: 256}, index.Position(255))
+}
@@ -0,0 +1,177 @@
+package view
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A Matcher finds all occurrences of a fixed set of pattern views in a target
+// view, using the Aho-Corasick automaton.
+//
+// The matcher is built once from the set of patterns in time linear in their
+// total length, and then scans any target view in a single pass, in time linear
+// in the length of the target plus the number of reported matches.
+//
+// A Matcher is immutable after construction, and is safe to use concurrently.
+type Matcher[T comparable, Offset constraints.Unsigned] struct {
+	// Trie transitions, keyed by the source state and the consumed item.
+	edges map[matcherEdge[T]]int
+
+	// For each state, the state of the longest proper suffix of the state
+	// string that is also a prefix of some pattern.
+	fail []int
+
+	// For each state, the closest state in its failure chain (excluding itself)
+	// that ends at least one pattern, or -1 if no such state exists.
+	dict []int
+
+	// For each state, the ids of the patterns that end exactly at that state.
+	outputs [][]int
+
+	// The length of each pattern, indexed by pattern id.
+	lengths []Offset
+}
+
+type matcherEdge[T comparable] struct {
+	state int
+	item  T
+}
+
+const matcherRoot = 0
+
+// Build a new matcher from the provided patterns.
+// The id of each pattern is its index in the provided list. Duplicate
+// patterns are allowed, and each of them is reported under its own id.
+func NewMatcher[T comparable, Offset constraints.Unsigned](
+	patterns ...View[T, Offset],
+) *Matcher[T, Offset] {
+	m := &Matcher[T, Offset]{
+		edges:   make(map[matcherEdge[T]]int),
+		fail:    []int{matcherRoot},
+		dict:    []int{-1},
+		outputs: [][]int{nil},
+		lengths: make([]Offset, len(patterns)),
+	}
+
+	// children[s] holds the outgoing edges of state s, which are required for
+	// the breadth first traversal below, since the edges map is not ordered.
+	children := [][]matcherEdge[T]{nil}
+
+	for id, pattern := range patterns {
+		state := matcherRoot
+		for item := range pattern.Range() {
+			edge := matcherEdge[T]{state: state, item: item}
+			next, ok := m.edges[edge]
+			if !ok {
+				next = len(m.fail)
+				m.edges[edge] = next
+				m.fail = append(m.fail, matcherRoot)
+				m.dict = append(m.dict, -1)
+				m.outputs = append(m.outputs, nil)
+				children[state] = append(children[state], edge)
+				children = append(children, nil)
+			}
+			state = next
+		}
+		m.outputs[state] = append(m.outputs[state], id)
+		m.lengths[id] = pattern.Len()
+	}
+
+	queue := []int{matcherRoot}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range children[state] {
+			child := m.edges[edge]
+			if state != matcherRoot {
+				m.fail[child] = m.step(m.fail[state], edge.item)
+			}
+
+			fail := m.fail[child]
+			if len(m.outputs[fail]) > 0 {
+				m.dict[child] = fail
+			} else {
+				m.dict[child] = m.dict[fail]
+			}
+
+			queue = append(queue, child)
+		}
+	}
+
+	return m
+}
+
+// Returns the number of patterns the matcher was built from.
+func (m *Matcher[T, Offset]) Len() int {
+	return len(m.lengths)
+}
+
+// Advance the automaton from the provided state by consuming the provided item.
+func (m *Matcher[T, Offset]) step(state int, item T) int {
+	for {
+		if next, ok := m.edges[matcherEdge[T]{state: state, item: item}]; ok {
+			return next
+		}
+
+		if state == matcherRoot {
+			return matcherRoot
+		}
+
+		state = m.fail[state]
+	}
+}
+
+// Iterate over all pattern occurrences in the provided view (rangefunc).
+// Yields the pattern id and the matched subview of the provided view.
+//
+// Matches are reported in increasing order of their end index. Matches that
+// end at the same index are reported from the longest to the shortest.
+// Overlapping matches are all reported.
+func (m *Matcher[T, Offset]) MatchesUnmanaged(
+	v UnmanagedView[T, Offset], ctx ViewContext[T],
+) iter.Seq2[int, UnmanagedView[T, Offset]] {
+	return func(yield func(int, UnmanagedView[T, Offset]) bool) {
+		emit := func(state int, end Offset) bool {
+			for ; state != -1; state = m.dict[state] {
+				for _, id := range m.outputs[state] {
+					if !yield(id, v.Subview(end-m.lengths[id], end)) {
+						return false
+					}
+				}
+			}
+			return true
+		}
+
+		state := matcherRoot
+		if !emit(state, 0) {
+			return
+		}
+
+		for idx, item := range v.Range2(ctx) {
+			state = m.step(state, item)
+			if !emit(state, idx+1) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate over all pattern occurrences in the provided view (rangefunc).
+// Yields the pattern id and the matched subview of the provided view.
+//
+// Matches are reported in increasing order of their end index. Matches that
+// end at the same index are reported from the longest to the shortest.
+// Overlapping matches are all reported.
+func (m *Matcher[T, Offset]) Matches(
+	v View[T, Offset],
+) iter.Seq2[int, View[T, Offset]] {
+	return func(yield func(int, View[T, Offset]) bool) {
+		for id, match := range m.MatchesUnmanaged(v.unmanaged, v.ctx) {
+			if !yield(id, match.Attach(v.ctx)) {
+				return
+			}
+		}
+	}
+}
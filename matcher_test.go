@@ -0,0 +1,116 @@
+package view_test
+
+import (
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+type matcherResult struct {
+	id         int
+	start, end uint
+}
+
+func collectMatches[T comparable](
+	m *view.Matcher[T, uint], v view.View[T, uint],
+) []matcherResult {
+	results := []matcherResult{}
+	for id, match := range m.Matches(v) {
+		unmanaged := match.Unmanaged()
+		results = append(results, matcherResult{id, unmanaged.Start, unmanaged.End})
+	}
+	return results
+}
+
+func TestMatcherSimpleCase(t *testing.T) {
+	m := view.NewMatcher(
+		view.NewView[rune, uint]([]rune("he")),
+		view.NewView[rune, uint]([]rune("she")),
+		view.NewView[rune, uint]([]rune("his")),
+		view.NewView[rune, uint]([]rune("hers")),
+	)
+	v := view.NewView[rune, uint]([]rune("ushers"))
+
+	expected := []matcherResult{{1, 1, 4}, {0, 2, 4}, {3, 2, 6}}
+	assert.Equal(t, expected, collectMatches(m, v))
+}
+
+func TestMatcherSubviewOffsets(t *testing.T) {
+	m := view.NewMatcher(view.NewView[int, uint]([]int{2, 3}))
+	v := view.NewView[int, uint]([]int{2, 3, 1, 2, 3, 2}).Subview(1, 6)
+
+	expected := []matcherResult{{0, 3, 5}}
+	assert.Equal(t, expected, collectMatches(m, v))
+
+	for _, match := range m.Matches(v) {
+		assert.Equal(t, []int{2, 3}, match.Raw())
+	}
+}
+
+func TestMatcherOverlappingAndDuplicates(t *testing.T) {
+	m := view.NewMatcher(
+		view.NewView[int, uint]([]int{1, 1}),
+		view.NewView[int, uint]([]int{1}),
+		view.NewView[int, uint]([]int{1, 1}),
+	)
+	v := view.NewView[int, uint]([]int{1, 1, 1})
+
+	expected := []matcherResult{
+		{1, 0, 1},
+		{0, 0, 2}, {2, 0, 2}, {1, 1, 2},
+		{0, 1, 3}, {2, 1, 3}, {1, 2, 3},
+	}
+	assert.Equal(t, expected, collectMatches(m, v))
+}
+
+func TestMatcherEmptyPattern(t *testing.T) {
+	m := view.NewMatcher(view.NewView[int, uint]([]int{}))
+	v := view.NewView[int, uint]([]int{5, 6})
+
+	expected := []matcherResult{{0, 0, 0}, {0, 1, 1}, {0, 2, 2}}
+	assert.Equal(t, expected, collectMatches(m, v))
+}
+
+func TestMatcherEarlyBreak(t *testing.T) {
+	m := view.NewMatcher(view.NewView[int, uint]([]int{7}))
+	v := view.NewView[int, uint]([]int{7, 7, 7, 7})
+
+	count := 0
+	for range m.Matches(v) {
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	assert.Equal(t, 2, count)
+}
+
+func TestMatcherMatchesNaive(t *testing.T) {
+	patterns := [][]int{{0, 1}, {1, 0, 1}, {1}, {0, 0, 0}, {1, 1, 0, 1}}
+	data := []int{0, 0, 0, 1, 1, 0, 1, 0, 1, 1, 0, 0, 0, 0, 1}
+
+	views := []view.View[int, uint]{}
+	for _, pattern := range patterns {
+		views = append(views, view.NewView[int, uint](pattern))
+	}
+	m := view.NewMatcher(views...)
+	assert.Equal(t, len(patterns), m.Len())
+
+	v := view.NewView[int, uint](data)
+	counts := make([]int, len(patterns))
+	for id, match := range m.Matches(v) {
+		assert.Equal(t, patterns[id], match.Raw())
+		counts[id]++
+	}
+
+	for id, pattern := range patterns {
+		expected := 0
+		for i := 0; i+len(pattern) <= len(data); i++ {
+			if view.NewView[int, uint](data[i : i+len(pattern)]).Equal(views[id]) {
+				expected++
+			}
+		}
+		assert.Equal(t, expected, counts[id])
+	}
+}
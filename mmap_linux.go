@@ -0,0 +1,80 @@
+//go:build linux
+
+package view
+
+import (
+	"errors"
+	"os"
+	"syscall"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A Mapping is a read-only memory mapping of a file, which can serve as the
+// context of byte views directly over the file pages, without reading the
+// file into memory.
+//
+// The context must not be modified: the pages are mapped read-only, and
+// writing to them crashes the program. After Close is called, the context,
+// and all views over it, must not be used anymore.
+type Mapping struct {
+	ctx ViewContext[byte]
+}
+
+// Map the file at the provided path into memory, and return the mapping
+// together with a view that spans over the whole file.
+//
+// Returns an error if the file cannot be mapped, or if its size cannot be
+// represented by the Offset type.
+func MapFile[Offset constraints.Unsigned](path string) (*Mapping, View[byte, Offset], error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, View[byte, Offset]{}, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, View[byte, Offset]{}, err
+	}
+
+	size := info.Size()
+	if int64(int(size)) != size {
+		return nil, View[byte, Offset]{}, errors.New("file too large to map")
+	}
+
+	if err := checkLength[Offset](int(size)); err != nil {
+		return nil, View[byte, Offset]{}, err
+	}
+
+	mapping := &Mapping{}
+	if size > 0 {
+		data, err := syscall.Mmap(
+			int(file.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED,
+		)
+		if err != nil {
+			return nil, View[byte, Offset]{}, err
+		}
+		mapping.ctx = data
+	}
+
+	unmanaged := UnmanagedView[byte, Offset]{Start: 0, End: Offset(size)}
+	return mapping, unmanaged.Attach(mapping.ctx), nil
+}
+
+// Returns the context of the mapping, which holds the file content.
+func (m *Mapping) Ctx() ViewContext[byte] {
+	return m.ctx
+}
+
+// Unmap the file from memory.
+// Calling Close more than once has no effect.
+func (m *Mapping) Close() error {
+	if m.ctx == nil {
+		return nil
+	}
+
+	err := syscall.Munmap(m.ctx)
+	m.ctx = nil
+	return err
+}
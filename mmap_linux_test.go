@@ -0,0 +1,50 @@
+//go:build linux
+
+package view_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("foo bar baz"), 0o644))
+
+	mapping, v, err := view.MapFile[uint64](path)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []byte("foo bar baz"), v.Raw())
+	assert.EqualValues(t, 3, v.Index(' '))
+	assert.Len(t, v.Fields(func(b byte) bool { return b == ' ' }), 3)
+
+	assert.NoError(t, mapping.Close())
+	assert.NoError(t, mapping.Close())
+}
+
+func TestMapFileEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.txt")
+	assert.NoError(t, os.WriteFile(path, nil, 0o644))
+
+	mapping, v, err := view.MapFile[uint64](path)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, v.Len())
+	assert.NoError(t, mapping.Close())
+}
+
+func TestMapFileTooLarge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "large.bin")
+	assert.NoError(t, os.WriteFile(path, make([]byte, 300), 0o644))
+
+	_, _, err := view.MapFile[uint8](path)
+	assert.Error(t, err)
+}
+
+func TestMapFileNotFound(t *testing.T) {
+	_, _, err := view.MapFile[uint64](filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
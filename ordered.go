@@ -0,0 +1,27 @@
+package view
+
+import (
+	"cmp"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Compares the content of the provided unmanaged views lexicographically,
+// similarly to slices.Compare. Each view is followed by its context.
+//
+// The result is 0 if v == u, -1 if v < u, and +1 if v > u.
+func CompareUnmanaged[T cmp.Ordered, Offset constraints.Unsigned](
+	v UnmanagedView[T, Offset], vctx ViewContext[T],
+	u UnmanagedView[T, Offset], uctx ViewContext[T],
+) int {
+	return v.CompareFunc(vctx, u, uctx, cmp.Compare[T])
+}
+
+// Compares the content of the provided views lexicographically, similarly to
+// slices.Compare. Can be used directly as the comparison function of
+// slices.SortFunc.
+//
+// The result is 0 if v == u, -1 if v < u, and +1 if v > u.
+func Compare[T cmp.Ordered, Offset constraints.Unsigned](v, u View[T, Offset]) int {
+	return CompareUnmanaged(v.unmanaged, v.ctx, u.unmanaged, u.ctx)
+}
@@ -0,0 +1,53 @@
+package view_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareSimpleCase(t *testing.T) {
+	a := view.NewView[rune, uint]([]rune("xxabc")).Subview(2, 5)
+	b := view.NewView[rune, uint]([]rune("abd"))
+	assert.Equal(t, -1, view.Compare(a, b))
+	assert.Equal(t, 1, view.Compare(b, a))
+	assert.Equal(t, 0, view.Compare(a, a))
+}
+
+func TestComparePrefix(t *testing.T) {
+	a := view.NewView[int, uint]([]int{1, 2})
+	b := view.NewView[int, uint]([]int{1, 2, 0})
+	assert.Equal(t, -1, view.Compare(a, b))
+	assert.Equal(t, 1, view.Compare(b, a))
+
+	ua, actx := a.Detach()
+	ub, bctx := b.Detach()
+	assert.Equal(t, -1, view.CompareUnmanaged(ua, actx, ub, bctx))
+}
+
+func TestCompareSort(t *testing.T) {
+	words := []string{"pear", "apple", "app", "banana", "", "apricot"}
+	views := []view.View[rune, uint]{}
+	for _, word := range words {
+		views = append(views, view.NewView[rune, uint]([]rune(word)))
+	}
+
+	slices.SortFunc(views, view.Compare[rune, uint])
+	slices.Sort(words)
+
+	for i, v := range views {
+		assert.Equal(t, words[i], string(v.Raw()))
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	a := view.NewView[string, uint]([]string{"Foo", "BAR"})
+	b := view.NewView[string, uint]([]string{"foo", "bar"})
+	assert.NotEqual(t, 0, a.CompareFunc(b, strings.Compare))
+	assert.Equal(t, 0, a.CompareFunc(b, func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	}))
+}
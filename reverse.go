@@ -0,0 +1,153 @@
+package view
+
+import (
+	"errors"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A ReversedView is an adapter over a view, that provides access to the items
+// of the view in reverse order, without copying the underlying context.
+//
+// Index 0 of the reversed view is the last item of the original view, so
+// right-to-left scanning (such as parsing suffixes) can be written in the same
+// terms as left-to-right scanning. All indices are relative to the reversed
+// view, and all views that are returned are reversed as well.
+//
+// Reversed views are compared with other reversed views: r.HasPrefix(p) is
+// true iff the original view of r ends with the original view of p.
+type ReversedView[T comparable, Offset constraints.Unsigned] struct {
+	view View[T, Offset]
+}
+
+// Returns the original view, in its original order.
+func (r ReversedView[T, Offset]) Reverse() View[T, Offset] {
+	return r.view
+}
+
+// Returns the number of items in the view.
+func (r ReversedView[T, Offset]) Len() Offset {
+	return r.view.Len()
+}
+
+// Returns the item at the provided index of the reversed view, which is the
+// item at index Len() - 1 - index of the original view.
+func (r ReversedView[T, Offset]) At(index Offset) (T, error) {
+	if index >= r.Len() {
+		var t T
+		return t, errors.New("index out of view bounds")
+	}
+	return r.AtUnsafe(index), nil
+}
+
+// Returns the item at the provided index of the reversed view, without bound
+// checks.
+func (r ReversedView[T, Offset]) AtUnsafe(index Offset) T {
+	return r.view.AtUnsafe(r.Len() - 1 - index)
+}
+
+// Returns the first item in the reversed view, which is the last item of the
+// original view.
+func (r ReversedView[T, Offset]) Front() (T, error) {
+	return r.view.Back()
+}
+
+// Returns the first item in the reversed view, without bound checks.
+func (r ReversedView[T, Offset]) FrontUnsafe() T {
+	return r.view.BackUnsafe()
+}
+
+// Returns the last item in the reversed view, which is the first item of the
+// original view.
+func (r ReversedView[T, Offset]) Back() (T, error) {
+	return r.view.Front()
+}
+
+// Returns the last item in the reversed view, without bound checks.
+func (r ReversedView[T, Offset]) BackUnsafe() T {
+	return r.view.FrontUnsafe()
+}
+
+// Return a reversed subview of the reversed view.
+// Start and End indices are relative to the reversed view bounds, and are
+// clamped similarly to View.Subview.
+func (r ReversedView[T, Offset]) Subview(start, end Offset) ReversedView[T, Offset] {
+	end = min(end, r.Len())
+	start = min(start, end)
+	return r.view.Subview(r.Len()-end, r.Len()-start).Reverse()
+}
+
+// Iterate over all values in the reversed view (rangefunc).
+func (r ReversedView[T, Offset]) Range() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range r.view.Backward() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate over all indices and values in the reversed view (rangefunc).
+// Indices are relative to the reversed view.
+func (r ReversedView[T, Offset]) Range2() iter.Seq2[Offset, T] {
+	return func(yield func(Offset, T) bool) {
+		for idx, item := range r.view.Backward() {
+			if !yield(r.Len()-1-idx, item) {
+				return
+			}
+		}
+	}
+}
+
+// Returns true if the reversed views are identical in their content.
+func (r ReversedView[T, Offset]) Equal(o ReversedView[T, Offset]) bool {
+	return r.view.Equal(o.view)
+}
+
+// Find the first item in the reversed view that equals to the provided item.
+// Return the index of such item (relative to the reversed view).
+//
+// If no item equals to the provided item, returns r.Len().
+func (r ReversedView[T, Offset]) Index(item T) Offset {
+	return r.IndexFunc(func(cur T) bool { return cur == item })
+}
+
+// Find the first item in the reversed view that returns true on the provided
+// predicate. Return the index of such item (relative to the reversed view).
+//
+// If no items return true on the provided predicate, returns r.Len().
+func (r ReversedView[T, Offset]) IndexFunc(f func(T) bool) Offset {
+	idx := r.view.LastIndexFunc(f)
+	if idx == r.Len() {
+		return r.Len()
+	}
+	return r.Len() - 1 - idx
+}
+
+// Returns true iff the provided reversed view is a prefix of the current one,
+// i.e. the original view ends with the original view of prefix.
+func (r ReversedView[T, Offset]) HasPrefix(prefix ReversedView[T, Offset]) bool {
+	return r.view.HasSuffix(prefix.view)
+}
+
+// Returns true iff the provided reversed view is a suffix of the current one,
+// i.e. the original view starts with the original view of suffix.
+func (r ReversedView[T, Offset]) HasSuffix(suffix ReversedView[T, Offset]) bool {
+	return r.view.HasPrefix(suffix.view)
+}
+
+// Returns the reversed view without the provided reversed prefix view, and
+// true. If the reversed view doesn't start with prefix, returns it unchanged,
+// and false.
+func (r ReversedView[T, Offset]) CutPrefix(prefix ReversedView[T, Offset]) (ReversedView[T, Offset], bool) {
+	before, found := r.view.CutSuffix(prefix.view)
+	return before.Reverse(), found
+}
+
+// Returns a reversed subview with all leading items of the reversed view that
+// satisfy f(item) removed.
+func (r ReversedView[T, Offset]) TrimLeftFunc(f func(T) bool) ReversedView[T, Offset] {
+	return r.view.TrimRightFunc(f).Reverse()
+}
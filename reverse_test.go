@@ -0,0 +1,94 @@
+package view_test
+
+import (
+	"slices"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackward(t *testing.T) {
+	v := view.NewView[int, uint]([]int{0, 1, 2, 3, 4}).Subview(1, 4)
+
+	indices, items := []uint{}, []int{}
+	for idx, item := range v.Backward() {
+		indices = append(indices, idx)
+		items = append(items, item)
+	}
+	assert.Equal(t, []uint{2, 1, 0}, indices)
+	assert.Equal(t, []int{3, 2, 1}, items)
+
+	for idx := range v.Backward() {
+		assert.Equal(t, uint(2), idx)
+		break
+	}
+
+	empty := view.NewView[int, uint]([]int{1}).Subview(1, 1)
+	for range empty.Backward() {
+		assert.Fail(t, "empty view yielded an item")
+	}
+}
+
+func TestReversedViewAccess(t *testing.T) {
+	r := view.NewView[rune, uint]([]rune("xabcx")).Subview(1, 4).Reverse()
+	assert.Equal(t, uint(3), r.Len())
+
+	front, err := r.Front()
+	assert.NoError(t, err)
+	assert.Equal(t, 'c', front)
+	assert.Equal(t, 'c', r.FrontUnsafe())
+
+	back, err := r.Back()
+	assert.NoError(t, err)
+	assert.Equal(t, 'a', back)
+	assert.Equal(t, 'a', r.BackUnsafe())
+
+	item, err := r.At(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 'b', item)
+
+	_, err = r.At(3)
+	assert.Error(t, err)
+
+	_, err = view.NewView[rune, uint](nil).Reverse().At(0)
+	assert.Error(t, err)
+
+	assert.Equal(t, []rune("cba"), slices.Collect(r.Range()))
+	assert.Equal(t, "abc", string(r.Reverse().Raw()))
+
+	for idx, item := range r.Range2() {
+		assert.Equal(t, r.AtUnsafe(idx), item)
+	}
+}
+
+func TestReversedViewSubview(t *testing.T) {
+	r := view.NewView[rune, uint]([]rune("abcdef")).Reverse()
+	sub := r.Subview(1, 4)
+	assert.Equal(t, []rune("edc"), slices.Collect(sub.Range()))
+	assert.Equal(t, "cde", string(sub.Reverse().Raw()))
+	assert.Equal(t, uint(0), r.Subview(4, 10).Subview(3, 1).Len())
+}
+
+func TestReversedViewSuffixParsing(t *testing.T) {
+	word := view.NewView[rune, uint]([]rune("running")).Reverse()
+	ing := view.NewView[rune, uint]([]rune("ing")).Reverse()
+	ed := view.NewView[rune, uint]([]rune("ed")).Reverse()
+
+	assert.True(t, word.HasPrefix(ing))
+	assert.False(t, word.HasPrefix(ed))
+	assert.True(t, word.HasSuffix(view.NewView[rune, uint]([]rune("run")).Reverse()))
+
+	stem, found := word.CutPrefix(ing)
+	assert.True(t, found)
+	assert.Equal(t, "runn", string(stem.Reverse().Raw()))
+
+	assert.Equal(t, uint(0), stem.Index('n'))
+	assert.Equal(t, uint(2), stem.Index('u'))
+	assert.Equal(t, uint(4), stem.Index('x'))
+	assert.Equal(t, uint(2), stem.IndexFunc(func(r rune) bool { return r != 'n' }))
+
+	trimmed := stem.TrimLeftFunc(func(r rune) bool { return r == 'n' })
+	assert.Equal(t, "ru", string(trimmed.Reverse().Raw()))
+	assert.True(t, trimmed.Equal(view.NewView[rune, uint]([]rune("ru")).Reverse()))
+}
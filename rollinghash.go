@@ -0,0 +1,104 @@
+package view
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"math/rand/v2"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A RollingHash holds the polynomial (Rabin-Karp) hashes of all prefixes of a
+// view context, which allows comparing any two views over the context in O(1)
+// time, after an O(n) preprocessing pass.
+//
+// Hashes are computed modulo the Mersenne prime 2^61-1, with a random base, so
+// two different views of length at most n have the same hash with probability
+// of at most n/2^61. Use EqualVerified where false positives are not
+// acceptable.
+//
+// All views passed to a RollingHash must be views over the context it was
+// built from. A RollingHash is immutable after construction, and is safe to
+// use concurrently.
+type RollingHash[T comparable, Offset constraints.Unsigned] struct {
+	ctx ViewContext[T]
+
+	// prefixes[i] is the hash of ctx[:i], and powers[i] is base^i.
+	prefixes []uint64
+	powers   []uint64
+}
+
+const rollingHashModulus = 1<<61 - 1
+
+// Build the prefix hashes of the provided context.
+func NewRollingHash[T comparable, Offset constraints.Unsigned](
+	ctx ViewContext[T],
+) *RollingHash[T, Offset] {
+	seed := maphash.MakeSeed()
+	base := rand.Uint64N(rollingHashModulus-256) + 256
+
+	prefixes := make([]uint64, len(ctx)+1)
+	powers := make([]uint64, len(ctx)+1)
+	powers[0] = 1
+
+	for i, item := range ctx {
+		value := maphash.Comparable(seed, item) % rollingHashModulus
+		prefixes[i+1] = rollingHashAdd(rollingHashMul(prefixes[i], base), value)
+		powers[i+1] = rollingHashMul(powers[i], base)
+	}
+
+	return &RollingHash[T, Offset]{ctx: ctx, prefixes: prefixes, powers: powers}
+}
+
+// Returns the hash of the content of the provided view, in O(1) time.
+// Views with equal content have equal hashes, regardless of their offsets.
+func (h *RollingHash[T, Offset]) Hash(v UnmanagedView[T, Offset]) uint64 {
+	scaled := rollingHashMul(h.prefixes[v.Start], h.powers[v.Len()])
+	return rollingHashAdd(h.prefixes[v.End], rollingHashModulus-scaled)
+}
+
+// Returns true if the provided views have equal content, in O(1) time.
+// Views with different content are reported as equal with a negligible
+// probability. See RollingHash for more details.
+func (h *RollingHash[T, Offset]) Equal(v, u UnmanagedView[T, Offset]) bool {
+	return v.Len() == u.Len() && h.Hash(v) == h.Hash(u)
+}
+
+// Returns true iff the provided views have equal content.
+// Runs in O(1) time if the hashes of the views differ, and otherwise verifies
+// the equality item by item.
+func (h *RollingHash[T, Offset]) EqualVerified(v, u UnmanagedView[T, Offset]) bool {
+	return h.Equal(v, u) && v.Equal(h.ctx, u, h.ctx)
+}
+
+// Returns the longest common prefix of the provided views, as a subview of v,
+// in O(log n) time, using a binary search over the prefix length.
+// The result may be longer than the true longest common prefix with a
+// negligible probability. See RollingHash for more details.
+func (h *RollingHash[T, Offset]) LongestCommonPrefix(v, u UnmanagedView[T, Offset]) UnmanagedView[T, Offset] {
+	lo, hi := Offset(0), min(v.Len(), u.Len())
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if h.Hash(v.Subview(0, mid)) == h.Hash(u.Subview(0, mid)) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return v.Subview(0, lo)
+}
+
+func rollingHashAdd(a, b uint64) uint64 {
+	sum := a + b
+	if sum >= rollingHashModulus {
+		sum -= rollingHashModulus
+	}
+	return sum
+}
+
+func rollingHashMul(a, b uint64) uint64 {
+	// Since a, b < 2^61, the product is smaller than 2^122, and can be split
+	// into (product >> 61) * 2^61 + (product & modulus), where 2^61 = 1.
+	hi, lo := bits.Mul64(a, b)
+	return rollingHashAdd(hi<<3|lo>>61, lo&rollingHashModulus)
+}
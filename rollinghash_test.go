@@ -0,0 +1,56 @@
+package view_test
+
+import (
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollingHashEqual(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("abcabdabc"))
+	h := view.NewRollingHash[rune, uint](v.Ctx())
+
+	a := v.Subview(0, 3).Unmanaged()
+	b := v.Subview(6, 9).Unmanaged()
+	c := v.Subview(3, 6).Unmanaged()
+
+	assert.Equal(t, h.Hash(a), h.Hash(b))
+	assert.True(t, h.Equal(a, b))
+	assert.True(t, h.EqualVerified(a, b))
+	assert.False(t, h.Equal(a, c))
+	assert.False(t, h.Equal(a, v.Subview(0, 2).Unmanaged()))
+}
+
+func TestRollingHashMatchesNaive(t *testing.T) {
+	data := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5, 1, 4, 1, 5, 9, 2, 6}
+	v := view.NewView[int, uint](data)
+	h := view.NewRollingHash[int, uint](v.Ctx())
+
+	n := uint(len(data))
+	for i := uint(0); i <= n; i++ {
+		for j := i; j <= n; j++ {
+			for k := uint(0); k <= n; k++ {
+				a := v.Subview(i, j)
+				b := v.Subview(k, k+j-i)
+				assert.Equal(t, a.Equal(b), h.Equal(a.Unmanaged(), b.Unmanaged()))
+			}
+		}
+	}
+}
+
+func TestRollingHashLongestCommonPrefix(t *testing.T) {
+	data := []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5, 1, 4, 1, 5, 9, 2, 7}
+	v := view.NewView[int, uint](data)
+	h := view.NewRollingHash[int, uint](v.Ctx())
+
+	n := uint(len(data))
+	for i := uint(0); i <= n; i++ {
+		for j := uint(0); j <= n; j++ {
+			a := v.Subview(i, n)
+			b := v.Subview(j, n)
+			expected := a.LongestCommonPrefix(b).Unmanaged()
+			assert.Equal(t, expected, h.LongestCommonPrefix(a.Unmanaged(), b.Unmanaged()))
+		}
+	}
+}
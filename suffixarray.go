@@ -0,0 +1,309 @@
+package view
+
+import (
+	"cmp"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A SuffixArray holds the lexicographically sorted suffixes of a view,
+// together with the longest common prefix (LCP) array of adjacent suffixes.
+// It answers pattern occurrence and repeated subview queries without scanning
+// the whole view.
+//
+// The suffix array is built with the SA-IS algorithm, and the LCP array with
+// Kasai's algorithm, both in linear time, after the items of the view are
+// mapped to an integer alphabet by sorting, in O(n log n) time.
+//
+// The context of the view must not be modified while the suffix array is in
+// use. A SuffixArray is immutable after construction, and is safe to use
+// concurrently.
+type SuffixArray[T cmp.Ordered, Offset constraints.Unsigned] struct {
+	view View[T, Offset]
+
+	// The start indices of the suffixes of the view, relative to the view
+	// start, in increasing lexicographical order.
+	suffixes []Offset
+
+	// lcp[i] is the length of the longest common prefix of the suffixes at
+	// suffixes[i-1] and suffixes[i]. lcp[0] is always 0.
+	lcp []Offset
+}
+
+// Build the suffix array and the LCP array of the provided view.
+func NewSuffixArray[T cmp.Ordered, Offset constraints.Unsigned](
+	v View[T, Offset],
+) *SuffixArray[T, Offset] {
+	raw := v.Raw()
+	n := len(raw)
+
+	alphabet := slices.Clone(raw)
+	slices.Sort(alphabet)
+	alphabet = slices.Compact(alphabet)
+
+	// Map each item to its rank in the alphabet, reserving 0 for a sentinel
+	// that is appended to the end of the text.
+	text := make([]int, n+1)
+	for i, item := range raw {
+		rank, _ := slices.BinarySearch(alphabet, item)
+		text[i] = rank + 1
+	}
+
+	sa := sais(text, len(alphabet)+1)
+
+	// The first suffix is always the sentinel alone.
+	suffixes := make([]Offset, n)
+	for i := range suffixes {
+		suffixes[i] = Offset(sa[i+1])
+	}
+
+	return &SuffixArray[T, Offset]{
+		view:     v,
+		suffixes: suffixes,
+		lcp:      kasai[T, Offset](raw, sa[1:]),
+	}
+}
+
+// Returns the view that the suffix array was built from.
+func (s *SuffixArray[T, Offset]) View() View[T, Offset] {
+	return s.view
+}
+
+// Returns the start indices of all suffixes of the view (relative to the view
+// start), in increasing lexicographical order of the suffixes.
+// The returned slice must not be modified.
+func (s *SuffixArray[T, Offset]) Suffixes() []Offset {
+	return s.suffixes
+}
+
+// Returns the LCP array, where the i-th entry is the length of the longest
+// common prefix of the (i-1)-th and the i-th suffixes in lexicographical order.
+// The first entry is always 0. The returned slice must not be modified.
+func (s *SuffixArray[T, Offset]) LCP() []Offset {
+	return s.lcp
+}
+
+// Returns all occurrences of the provided pattern in the view, as subviews of
+// the view, ordered by their start index.
+//
+// Runs in O(m log n + k log k) time, where m is the length of the pattern and
+// k is the number of occurrences.
+func (s *SuffixArray[T, Offset]) Occurrences(pattern View[T, Offset]) []View[T, Offset] {
+	m := pattern.Len()
+
+	// Compares the prefix of length m of the suffix with the pattern.
+	compare := func(suffix Offset, pattern View[T, Offset]) int {
+		return Compare(s.view.Subview(suffix, suffix+min(m, s.view.Len()-suffix)), pattern)
+	}
+
+	lo, _ := slices.BinarySearchFunc(s.suffixes, pattern, compare)
+	hi, _ := slices.BinarySearchFunc(
+		s.suffixes[lo:], pattern,
+		func(suffix Offset, pattern View[T, Offset]) int {
+			// Never report a match, to find the first suffix that is greater.
+			if compare(suffix, pattern) <= 0 {
+				return -1
+			}
+			return 1
+		},
+	)
+
+	starts := slices.Clone(s.suffixes[lo : lo+hi])
+	slices.Sort(starts)
+
+	occurrences := make([]View[T, Offset], len(starts))
+	for i, start := range starts {
+		occurrences[i] = s.view.Subview(start, start+m)
+	}
+	return occurrences
+}
+
+// Returns the longest subview that occurs at least twice in the view (the
+// occurrences may overlap). If there are several such subviews, the
+// lexicographically smallest one is returned. If no item repeats in the view,
+// an empty subview is returned.
+func (s *SuffixArray[T, Offset]) LongestRepeated() View[T, Offset] {
+	if len(s.lcp) == 0 {
+		return s.view.Subview(0, 0)
+	}
+
+	best := 0
+	for i, length := range s.lcp {
+		if length > s.lcp[best] {
+			best = i
+		}
+	}
+
+	start := s.suffixes[best]
+	return s.view.Subview(start, start+s.lcp[best])
+}
+
+// Computes the suffix array of the provided text using the SA-IS algorithm.
+// All values of the text must be in [0, k), and the last value of the text
+// must be a unique 0 sentinel.
+func sais(text []int, k int) []int {
+	n := len(text)
+	sa := make([]int, n)
+	if n == 1 {
+		return sa
+	}
+
+	// Classify each suffix as S-type (smaller than the following suffix) or
+	// L-type (larger than the following suffix).
+	isS := make([]bool, n)
+	isS[n-1] = true
+	for i := n - 2; i >= 0; i-- {
+		isS[i] = text[i] < text[i+1] || (text[i] == text[i+1] && isS[i+1])
+	}
+
+	isLMS := func(i int) bool {
+		return i > 0 && isS[i] && !isS[i-1]
+	}
+
+	sizes := make([]int, k)
+	for _, c := range text {
+		sizes[c]++
+	}
+
+	bucketStarts := func() []int {
+		starts := make([]int, k)
+		sum := 0
+		for c, size := range sizes {
+			starts[c] = sum
+			sum += size
+		}
+		return starts
+	}
+
+	bucketEnds := func() []int {
+		ends := make([]int, k)
+		sum := 0
+		for c, size := range sizes {
+			sum += size
+			ends[c] = sum
+		}
+		return ends
+	}
+
+	// Induce the order of all suffixes from the order of the LMS suffixes.
+	induce := func(lms []int) {
+		for i := range sa {
+			sa[i] = -1
+		}
+
+		ends := bucketEnds()
+		for i := len(lms) - 1; i >= 0; i-- {
+			c := text[lms[i]]
+			ends[c]--
+			sa[ends[c]] = lms[i]
+		}
+
+		starts := bucketStarts()
+		for i := 0; i < n; i++ {
+			if j := sa[i] - 1; j >= 0 && !isS[j] {
+				sa[starts[text[j]]] = j
+				starts[text[j]]++
+			}
+		}
+
+		ends = bucketEnds()
+		for i := n - 1; i >= 0; i-- {
+			if j := sa[i] - 1; j >= 0 && isS[j] {
+				ends[text[j]]--
+				sa[ends[text[j]]] = j
+			}
+		}
+	}
+
+	lms := make([]int, 0)
+	for i := 1; i < n; i++ {
+		if isLMS(i) {
+			lms = append(lms, i)
+		}
+	}
+
+	induce(lms)
+
+	// Returns true iff the LMS substrings that start at a and b are equal.
+	lmsEqual := func(a, b int) bool {
+		for d := 0; ; d++ {
+			if text[a+d] != text[b+d] || isS[a+d] != isS[b+d] {
+				return false
+			}
+			if d > 0 && (isLMS(a+d) || isLMS(b+d)) {
+				return isLMS(a+d) && isLMS(b+d)
+			}
+		}
+	}
+
+	// Name the LMS substrings by their induced order, where equal substrings
+	// get equal names.
+	names := make([]int, n)
+	name, prev := -1, -1
+	for _, p := range sa {
+		if !isLMS(p) {
+			continue
+		}
+		if prev < 0 || !lmsEqual(prev, p) {
+			name++
+		}
+		names[p] = name
+		prev = p
+	}
+
+	reduced := make([]int, len(lms))
+	for i, p := range lms {
+		reduced[i] = names[p]
+	}
+
+	// Sort the LMS suffixes, recursively if their names are not unique.
+	var reducedSA []int
+	if name+1 == len(lms) {
+		reducedSA = make([]int, len(lms))
+		for i, c := range reduced {
+			reducedSA[c] = i
+		}
+	} else {
+		reducedSA = sais(reduced, name+1)
+	}
+
+	sorted := make([]int, len(lms))
+	for i, j := range reducedSA {
+		sorted[i] = lms[j]
+	}
+
+	induce(sorted)
+	return sa
+}
+
+// Computes the LCP array of the provided text and its suffix array using
+// Kasai's algorithm.
+func kasai[T comparable, Offset constraints.Unsigned](text []T, sa []int) []Offset {
+	n := len(text)
+	rank := make([]int, n)
+	for i, suffix := range sa {
+		rank[suffix] = i
+	}
+
+	lcp := make([]Offset, n)
+	h := 0
+	for i := 0; i < n; i++ {
+		if rank[i] == 0 {
+			h = 0
+			continue
+		}
+
+		j := sa[rank[i]-1]
+		for i+h < n && j+h < n && text[i+h] == text[j+h] {
+			h++
+		}
+
+		lcp[rank[i]] = Offset(h)
+		if h > 0 {
+			h--
+		}
+	}
+
+	return lcp
+}
@@ -0,0 +1,84 @@
+package view_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func naiveSuffixArray(data []int) []uint {
+	suffixes := make([]uint, len(data))
+	for i := range suffixes {
+		suffixes[i] = uint(i)
+	}
+	slices.SortFunc(suffixes, func(a, b uint) int {
+		return slices.Compare(data[a:], data[b:])
+	})
+	return suffixes
+}
+
+func TestSuffixArrayBanana(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("xbananax")).Subview(1, 7)
+	sa := view.NewSuffixArray(v)
+
+	assert.Equal(t, []uint{5, 3, 1, 0, 4, 2}, sa.Suffixes())
+	assert.Equal(t, []uint{0, 1, 3, 0, 0, 2}, sa.LCP())
+	assert.Equal(t, []rune("ana"), sa.LongestRepeated().Raw())
+}
+
+func TestSuffixArrayMatchesNaive(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	for length := 0; length < 60; length++ {
+		for alphabet := 1; alphabet <= 4; alphabet++ {
+			data := make([]int, length)
+			for i := range data {
+				data[i] = random.IntN(alphabet) * 10
+			}
+
+			sa := view.NewSuffixArray(view.NewView[int, uint](data))
+			expected := naiveSuffixArray(data)
+			assert.Equal(t, expected, sa.Suffixes())
+
+			for i := 1; i < length; i++ {
+				a, b := data[expected[i-1]:], data[expected[i]:]
+				common := 0
+				for common < min(len(a), len(b)) && a[common] == b[common] {
+					common++
+				}
+				assert.EqualValues(t, common, sa.LCP()[i])
+			}
+		}
+	}
+}
+
+func TestSuffixArrayOccurrences(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("abracadabra"))
+	sa := view.NewSuffixArray(v)
+
+	starts := func(pattern string) []uint {
+		result := []uint{}
+		for _, occurrence := range sa.Occurrences(view.NewView[rune, uint]([]rune(pattern))) {
+			assert.Equal(t, []rune(pattern), occurrence.Raw())
+			result = append(result, occurrence.Unmanaged().Start)
+		}
+		return result
+	}
+
+	assert.Equal(t, []uint{0, 7}, starts("abra"))
+	assert.Equal(t, []uint{0, 3, 5, 7, 10}, starts("a"))
+	assert.Equal(t, []uint{}, starts("abrac_"))
+	assert.Equal(t, []uint{}, starts("z"))
+	assert.Len(t, starts(""), 11)
+}
+
+func TestSuffixArrayLongestRepeatedNone(t *testing.T) {
+	sa := view.NewSuffixArray(view.NewView[int, uint]([]int{1, 2, 3}))
+	assert.EqualValues(t, 0, sa.LongestRepeated().Len())
+
+	empty := view.NewSuffixArray(view.NewView[int, uint](nil))
+	assert.EqualValues(t, 0, empty.LongestRepeated().Len())
+	assert.Empty(t, empty.Occurrences(view.NewView[int, uint]([]int{1})))
+}
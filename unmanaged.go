@@ -2,6 +2,7 @@ package view
 
 import (
 	"errors"
+	"hash/maphash"
 	"iter"
 
 	"golang.org/x/exp/constraints"
@@ -32,6 +33,66 @@ func NewUnmanagedView[T comparable, Offset constraints.Unsigned](data []T) (
 	return view, ctx
 }
 
+// Create a new (unmanaged) view over an already existing slice, without
+// copying it. The view initially spans over the whole slice.
+//
+// The returned context aliases the provided slice: any modification of the
+// slice is visible through all views over the context, and vice versa.
+// The caller must not modify the slice while views over it are in use, unless
+// this is explicitly desired. When in doubt, use NewUnmanagedView, which
+// copies the slice.
+func BorrowUnmanagedView[T comparable, Offset constraints.Unsigned](data []T) (
+	UnmanagedView[T, Offset], ViewContext[T],
+) {
+	view := UnmanagedView[T, Offset]{
+		Start: 0,
+		End:   Offset(len(data)),
+	}
+
+	return view, ViewContext[T](data)
+}
+
+// Create a new (unmanaged) view from an already existing slice, similarly to
+// NewUnmanagedView.
+//
+// Returns an error if the length of the slice cannot be represented by the
+// Offset type, in which case the view would silently be truncated.
+func NewUnmanagedViewChecked[T comparable, Offset constraints.Unsigned](data []T) (
+	UnmanagedView[T, Offset], ViewContext[T], error,
+) {
+	if err := checkLength[Offset](len(data)); err != nil {
+		return UnmanagedView[T, Offset]{}, nil, err
+	}
+
+	view, ctx := NewUnmanagedView[T, Offset](data)
+	return view, ctx, nil
+}
+
+// Create a new (unmanaged) view over an already existing slice, without
+// copying it, similarly to BorrowUnmanagedView.
+//
+// Returns an error if the length of the slice cannot be represented by the
+// Offset type, in which case the view would silently be truncated.
+func BorrowUnmanagedViewChecked[T comparable, Offset constraints.Unsigned](data []T) (
+	UnmanagedView[T, Offset], ViewContext[T], error,
+) {
+	if err := checkLength[Offset](len(data)); err != nil {
+		return UnmanagedView[T, Offset]{}, nil, err
+	}
+
+	view, ctx := BorrowUnmanagedView[T, Offset](data)
+	return view, ctx, nil
+}
+
+// Returns an error if the provided length is greater than the maximal value
+// of the Offset type.
+func checkLength[Offset constraints.Unsigned](length int) error {
+	if uint64(length) > uint64(^Offset(0)) {
+		return errors.New("length exceeds the maximal view offset")
+	}
+	return nil
+}
+
 func (v UnmanagedView[T, Offset]) Attach(ctx ViewContext[T]) View[T, Offset] {
 	return View[T, Offset]{
 		unmanaged: v,
@@ -39,6 +100,39 @@ func (v UnmanagedView[T, Offset]) Attach(ctx ViewContext[T]) View[T, Offset] {
 	}
 }
 
+// Attach the view to the provided context, similarly to Attach.
+// Returns an error if the view is not consistent with the context.
+// See Check for more details.
+func (v UnmanagedView[T, Offset]) AttachChecked(ctx ViewContext[T]) (View[T, Offset], error) {
+	if err := v.Check(ctx); err != nil {
+		return View[T, Offset]{}, err
+	}
+	return v.Attach(ctx), nil
+}
+
+// Returns an error if the view is not consistent with the provided context,
+// that is, if v.Start > v.End or if v.End > len(ctx).
+//
+// Views that are consistent with their context are safe to use with all
+// methods that take the context as an argument.
+func (v UnmanagedView[T, Offset]) Check(ctx ViewContext[T]) error {
+	if v.Start > v.End {
+		return errors.New("view start is greater than view end")
+	}
+
+	if uint64(v.End) > uint64(len(ctx)) {
+		return errors.New("view end exceeds context length")
+	}
+
+	return nil
+}
+
+// Returns true iff the view is consistent with the provided context.
+// See Check for more details.
+func (v UnmanagedView[T, Offset]) Valid(ctx ViewContext[T]) bool {
+	return v.Check(ctx) == nil
+}
+
 // Returns the raw underlying slice that the view is bound to.
 func (v UnmanagedView[T, Offset]) Raw(ctx ViewContext[T]) []T {
 	return ctx[v.Start:v.End]
@@ -53,12 +147,13 @@ func (v UnmanagedView[T, Offset]) Len() Offset {
 // If the provided index goes out of the view bounds, an error is returned,
 // with an undefined value.
 func (v UnmanagedView[T, Offset]) At(ctx ViewContext[T], index Offset) (T, error) {
-	index += v.Start
-	if index >= v.End {
+	// Compare against the length before adding the start offset, since the
+	// addition may wrap around for small offset types.
+	if index >= v.Len() {
 		var t T
 		return t, errors.New("index out of view bounds")
 	}
-	return ctx[index], nil
+	return ctx[v.Start+index], nil
 }
 
 // Returns the item at the provided index, relative to the view bounds.
@@ -144,6 +239,126 @@ func (v UnmanagedView[T, Offset]) Equal(
 	return true
 }
 
+// Returns true if the underlying views are identical in their content, where
+// items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view, and the
+// second is the item of the other view at the same index.
+func (v UnmanagedView[T, Offset]) EqualFunc(
+	vctx ViewContext[T],
+	u UnmanagedView[T, Offset],
+	uctx ViewContext[T],
+	eq func(T, T) bool,
+) bool {
+	if v.Len() != u.Len() {
+		return false
+	}
+
+	n := v.Len()
+	for i := Offset(0); i < n; i++ {
+		if !eq(v.AtUnsafe(vctx, i), u.AtUnsafe(uctx, i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns a hash of the content of the view, using the provided seed.
+//
+// The hash is consistent with Equal: views with equal content have equal
+// hashes under the same seed, regardless of their contexts and offsets.
+func (v UnmanagedView[T, Offset]) Hash(ctx ViewContext[T], seed maphash.Seed) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+
+	raw := v.Raw(ctx)
+	if bytes, ok := any(raw).([]byte); ok {
+		h.Write(bytes)
+	} else {
+		for _, item := range raw {
+			maphash.WriteComparable(&h, item)
+		}
+	}
+
+	return h.Sum64()
+}
+
+// Compares the content of the current view and the provided view
+// lexicographically, using the provided comparison function on each pair of
+// items, similarly to slices.CompareFunc.
+//
+// The result is the first non-zero result of cmp. If one view is a prefix of
+// the other, the shorter view is considered the smaller one.
+func (v UnmanagedView[T, Offset]) CompareFunc(
+	vctx ViewContext[T],
+	u UnmanagedView[T, Offset],
+	uctx ViewContext[T],
+	cmp func(T, T) int,
+) int {
+	n := min(v.Len(), u.Len())
+	for i := Offset(0); i < n; i++ {
+		if c := cmp(v.AtUnsafe(vctx, i), u.AtUnsafe(uctx, i)); c != 0 {
+			return c
+		}
+	}
+
+	if v.Len() < u.Len() {
+		return -1
+	} else if v.Len() > u.Len() {
+		return 1
+	}
+
+	return 0
+}
+
+// Returns the Levenshtein distance between the current view and the provided
+// one: the minimal number of item insertions, deletions and substitutions
+// required to transform one view into the other.
+func (v UnmanagedView[T, Offset]) Levenshtein(
+	vctx ViewContext[T], u UnmanagedView[T, Offset], uctx ViewContext[T],
+) int {
+	distance, _ := v.LevenshteinBounded(vctx, u, uctx, -1)
+	return distance
+}
+
+// Returns the Levenshtein distance between the current view and the provided
+// one, and true, if it is at most bound. Otherwise, returns false as soon as
+// the distance is known to exceed bound, with an undefined distance.
+// A negative bound disables the early exit.
+func (v UnmanagedView[T, Offset]) LevenshteinBounded(
+	vctx ViewContext[T], u UnmanagedView[T, Offset], uctx ViewContext[T], bound int,
+) (int, bool) {
+	eq := func(i, j int) bool {
+		return v.AtUnsafe(vctx, Offset(i)) == u.AtUnsafe(uctx, Offset(j))
+	}
+	return editDistance(int(v.Len()), int(u.Len()), eq, false, bound)
+}
+
+// Returns the Damerau-Levenshtein distance between the current view and the
+// provided one, in its optimal string alignment variant: similarly to the
+// Levenshtein distance, but a transposition of two adjacent items also counts
+// as a single edit, as long as no item is edited more than once.
+func (v UnmanagedView[T, Offset]) DamerauLevenshtein(
+	vctx ViewContext[T], u UnmanagedView[T, Offset], uctx ViewContext[T],
+) int {
+	distance, _ := v.DamerauLevenshteinBounded(vctx, u, uctx, -1)
+	return distance
+}
+
+// Returns the Damerau-Levenshtein distance between the current view and the
+// provided one, and true, if it is at most bound. Otherwise, returns false as
+// soon as the distance is known to exceed bound, with an undefined distance.
+// A negative bound disables the early exit.
+func (v UnmanagedView[T, Offset]) DamerauLevenshteinBounded(
+	vctx ViewContext[T], u UnmanagedView[T, Offset], uctx ViewContext[T], bound int,
+) (int, bool) {
+	eq := func(i, j int) bool {
+		return v.AtUnsafe(vctx, Offset(i)) == u.AtUnsafe(uctx, Offset(j))
+	}
+	return editDistance(int(v.Len()), int(u.Len()), eq, true, bound)
+}
+
 // Iterate over all values in the view (rangefunc).
 func (v UnmanagedView[T, Offset]) Range(ctx ViewContext[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
@@ -174,6 +389,19 @@ func (v UnmanagedView[T, Offset]) Range2(ctx ViewContext[T]) iter.Seq2[Offset, T
 	}
 }
 
+// Similar to slices.Backward.
+// Iterate over all indices and values in the view, from the last item to the
+// first (rangefunc). Indices are relative to the view start offset.
+func (v UnmanagedView[T, Offset]) Backward(ctx ViewContext[T]) iter.Seq2[Offset, T] {
+	return func(yield func(Offset, T) bool) {
+		for viewIndex := v.Len(); viewIndex > 0; viewIndex-- {
+			if !yield(viewIndex-1, ctx[v.Start+viewIndex-1]) {
+				return
+			}
+		}
+	}
+}
+
 // Find the first item in the view bounds that equals to the provided item.
 // Return the index of such item (relative to the view start offset).
 //
@@ -202,6 +430,49 @@ func (v UnmanagedView[T, Offset]) IndexFunc(ctx ViewContext[T], f func(T) bool)
 	return v.Len()
 }
 
+// Find the last item in the view bounds that equals to the provided item.
+// Return the index of such item (relative to the view start offset).
+//
+// If no item equals to the provided item, returns v.Len().
+func (v UnmanagedView[T, Offset]) LastIndex(ctx ViewContext[T], item T) Offset {
+	return v.LastIndexFunc(ctx, func(cur T) bool { return cur == item })
+}
+
+// Find the last item in the view bounds that returns true on the provided predicate.
+// Return the index of such item (relative to the view start offset).
+//
+// If no items return true on the provided predicate, returns v.Len().
+func (v UnmanagedView[T, Offset]) LastIndexFunc(ctx ViewContext[T], f func(T) bool) Offset {
+	for idx := v.Len(); idx > 0; idx-- {
+		if f(v.AtUnsafe(ctx, idx-1)) {
+			return idx - 1
+		}
+	}
+
+	return v.Len()
+}
+
+// Find the first item in the view bounds that appears in the provided set view.
+// Return the index of such item (relative to the view start offset).
+//
+// Each item is looked up in the set linearly, so the set is expected to be
+// small. If no item appears in the set, returns v.Len().
+func (v UnmanagedView[T, Offset]) IndexAny(
+	ctx ViewContext[T], set UnmanagedView[T, Offset], setCtx ViewContext[T],
+) Offset {
+	return v.IndexFunc(ctx, func(item T) bool { return set.Contains(setCtx, item) })
+}
+
+// Find the last item in the view bounds that appears in the provided set view.
+// Return the index of such item (relative to the view start offset).
+//
+// If no item appears in the set, returns v.Len().
+func (v UnmanagedView[T, Offset]) LastIndexAny(
+	ctx ViewContext[T], set UnmanagedView[T, Offset], setCtx ViewContext[T],
+) Offset {
+	return v.LastIndexFunc(ctx, func(item T) bool { return set.Contains(setCtx, item) })
+}
+
 // Returns true iff the view contains the provided item.
 func (v UnmanagedView[T, Offset]) Contains(ctx ViewContext[T], item T) bool {
 	for cur := range v.Range(ctx) {
@@ -212,6 +483,17 @@ func (v UnmanagedView[T, Offset]) Contains(ctx ViewContext[T], item T) bool {
 	return false
 }
 
+// Returns true iff the view contains an item that returns true on the
+// provided predicate.
+func (v UnmanagedView[T, Offset]) ContainsFunc(ctx ViewContext[T], f func(T) bool) bool {
+	for cur := range v.Range(ctx) {
+		if f(cur) {
+			return true
+		}
+	}
+	return false
+}
+
 // Returns true iff the provided view is a prefix of the current view.
 func (v UnmanagedView[T, Offset]) HasPrefix(
 	ctx ViewContext[T],
@@ -252,6 +534,201 @@ func (v UnmanagedView[T, Offset]) HasSuffix(
 	return true
 }
 
+// Returns true iff the provided view is a prefix of the current view, where
+// items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v UnmanagedView[T, Offset]) HasPrefixFunc(
+	ctx ViewContext[T],
+	prefix UnmanagedView[T, Offset],
+	prefixCtx ViewContext[T],
+	eq func(T, T) bool,
+) bool {
+	if v.Len() < prefix.Len() {
+		return false
+	}
+
+	n := prefix.Len()
+	for i := Offset(0); i < n; i++ {
+		if !eq(v.AtUnsafe(ctx, i), prefix.AtUnsafe(prefixCtx, i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns true iff the provided view is a suffix of the current view, where
+// items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v UnmanagedView[T, Offset]) HasSuffixFunc(
+	ctx ViewContext[T],
+	suffix UnmanagedView[T, Offset],
+	suffixCtx ViewContext[T],
+	eq func(T, T) bool,
+) bool {
+	if v.Len() < suffix.Len() {
+		return false
+	}
+
+	n := suffix.Len()
+	for i := Offset(0); i < n; i++ {
+		if !eq(v.AtUnsafe(ctx, v.Len()-n+i), suffix.AtUnsafe(suffixCtx, i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Find the first occurrence of the provided needle view in the current view.
+// Return the index of the start of the occurrence (relative to the view start
+// offset). An empty needle is always found at index 0.
+//
+// The search runs in O(n+m) time in the worst case, where n and m are the
+// lengths of the current view and the needle view.
+//
+// If the needle does not appear in the view, returns v.Len().
+func (v UnmanagedView[T, Offset]) IndexView(
+	ctx ViewContext[T],
+	needle UnmanagedView[T, Offset],
+	needleCtx ViewContext[T],
+) Offset {
+	idx, found := kmpIndex(
+		v.Len(),
+		func(i Offset) T { return v.AtUnsafe(ctx, i) },
+		needle.Len(),
+		func(i Offset) T { return needle.AtUnsafe(needleCtx, i) },
+		equal[T],
+	)
+
+	if !found {
+		return v.Len()
+	}
+
+	return idx
+}
+
+// Find the last occurrence of the provided needle view in the current view.
+// Return the index of the start of the occurrence (relative to the view start
+// offset). An empty needle is always found at index v.Len().
+//
+// The search runs in O(n+m) time in the worst case, where n and m are the
+// lengths of the current view and the needle view.
+//
+// If the needle does not appear in the view, returns v.Len().
+func (v UnmanagedView[T, Offset]) LastIndexView(
+	ctx ViewContext[T],
+	needle UnmanagedView[T, Offset],
+	needleCtx ViewContext[T],
+) Offset {
+	n, m := v.Len(), needle.Len()
+	idx, found := kmpIndex(
+		n,
+		func(i Offset) T { return v.AtUnsafe(ctx, n-1-i) },
+		m,
+		func(i Offset) T { return needle.AtUnsafe(needleCtx, m-1-i) },
+		equal[T],
+	)
+
+	if !found {
+		return n
+	}
+
+	// idx is the index of the end of the occurrence in the reversed view.
+	return n - idx - m
+}
+
+// Returns true iff the provided needle view appears in the current view.
+func (v UnmanagedView[T, Offset]) ContainsView(
+	ctx ViewContext[T],
+	needle UnmanagedView[T, Offset],
+	needleCtx ViewContext[T],
+) bool {
+	_, found := kmpIndex(
+		v.Len(),
+		func(i Offset) T { return v.AtUnsafe(ctx, i) },
+		needle.Len(),
+		func(i Offset) T { return needle.AtUnsafe(needleCtx, i) },
+		equal[T],
+	)
+	return found
+}
+
+// Returns the number of items in the view that equal to the provided item.
+func (v UnmanagedView[T, Offset]) Count(ctx ViewContext[T], item T) Offset {
+	return v.CountFunc(ctx, func(cur T) bool { return cur == item })
+}
+
+// Returns the number of items in the view that return true on the provided
+// predicate.
+func (v UnmanagedView[T, Offset]) CountFunc(ctx ViewContext[T], f func(T) bool) Offset {
+	count := Offset(0)
+	for item := range v.Range(ctx) {
+		if f(item) {
+			count++
+		}
+	}
+	return count
+}
+
+// Similar to strings.Count.
+// Returns the number of non-overlapping occurrences of the provided needle
+// view in the current view. If the needle is empty, returns v.Len() + 1.
+//
+// The count is returned as an int, since v.Len() + 1 may not fit in Offset.
+func (v UnmanagedView[T, Offset]) CountView(
+	ctx ViewContext[T],
+	needle UnmanagedView[T, Offset],
+	needleCtx ViewContext[T],
+) int {
+	m := needle.Len()
+	if m == 0 {
+		return int(v.Len()) + 1
+	}
+
+	hay := func(i Offset) T { return v.AtUnsafe(ctx, i) }
+	at := func(i Offset) T { return needle.AtUnsafe(needleCtx, i) }
+	table := kmpFailureTable(m, at, equal[T])
+
+	count := 0
+	for start := Offset(0); ; count++ {
+		idx, found := kmpIndexFrom(table, v.Len(), hay, start, m, at, equal[T])
+		if !found {
+			return count
+		}
+		start = idx + m
+	}
+}
+
+// Find the first occurrence of the provided needle view in the current view,
+// where items are compared using the provided eq function, which must be an
+// equivalence relation. The first argument of eq is always an item of the
+// current view.
+//
+// See IndexView for more details.
+func (v UnmanagedView[T, Offset]) IndexViewFunc(
+	ctx ViewContext[T],
+	needle UnmanagedView[T, Offset],
+	needleCtx ViewContext[T],
+	eq func(T, T) bool,
+) Offset {
+	idx, found := kmpIndex(
+		v.Len(),
+		func(i Offset) T { return v.AtUnsafe(ctx, i) },
+		needle.Len(),
+		func(i Offset) T { return needle.AtUnsafe(needleCtx, i) },
+		eq,
+	)
+
+	if !found {
+		return v.Len()
+	}
+
+	return idx
+}
+
 // Returns the longest common prefix of the current view and the provided one.
 func (v UnmanagedView[T, Offset]) LongestCommonPrefix(
 	ctx ViewContext[T],
@@ -282,6 +759,44 @@ func (v UnmanagedView[T, Offset]) LongestCommonSuffix(
 	return v.Subview(v.Len()-n, v.Len())
 }
 
+// Returns the longest common prefix of the current view and the provided one,
+// where items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v UnmanagedView[T, Offset]) LongestCommonPrefixFunc(
+	ctx ViewContext[T],
+	u UnmanagedView[T, Offset],
+	uctx ViewContext[T],
+	eq func(T, T) bool,
+) UnmanagedView[T, Offset] {
+	n := min(v.Len(), u.Len())
+	for i := Offset(0); i < n; i++ {
+		if !eq(v.AtUnsafe(ctx, i), u.AtUnsafe(uctx, i)) {
+			return v.Subview(0, i)
+		}
+	}
+	return v.Subview(0, n)
+}
+
+// Returns the longest common suffix of the current view and the provided one,
+// where items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v UnmanagedView[T, Offset]) LongestCommonSuffixFunc(
+	ctx ViewContext[T],
+	u UnmanagedView[T, Offset],
+	uctx ViewContext[T],
+	eq func(T, T) bool,
+) UnmanagedView[T, Offset] {
+	n := min(v.Len(), u.Len())
+	for i := Offset(0); i < n; i++ {
+		if !eq(v.AtUnsafe(ctx, v.Len()-i-1), u.AtUnsafe(uctx, u.Len()-i-1)) {
+			return v.Subview(v.Len()-i, v.Len())
+		}
+	}
+	return v.Subview(v.Len()-n, v.Len())
+}
+
 // Merge this and the other provided view into a one bigger view.
 // This is done by setting newView.Start to min(v.Start, o.Start) and
 // newView.End to max(v.End, o.End).
@@ -337,25 +852,348 @@ func (v UnmanagedView[T, Offset]) Partition(
 // f always outputs the same value for a given input.
 func (v UnmanagedView[T, Offset]) Fields(ctx ViewContext[T], f func(T) bool) []UnmanagedView[T, Offset] {
 	fields := make([]UnmanagedView[T, Offset], 0)
+	for field := range v.FieldsSeq(ctx, f) {
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// Similar to strings.Split.
+// Splits the view into all subviews separated by the provided separator view,
+// and returns a slice of the subviews between those separators.
+//
+// Empty subviews are kept, so a view with k non-overlapping occurrences of the
+// separator is always split into k+1 subviews. If the separator is empty,
+// the view is split after each item.
+func (v UnmanagedView[T, Offset]) Split(
+	ctx ViewContext[T], sep UnmanagedView[T, Offset], sepCtx ViewContext[T],
+) []UnmanagedView[T, Offset] {
+	return v.genSplit(ctx, sep, sepCtx, 0, -1)
+}
+
+// Similar to strings.SplitN.
+// Splits the view into subviews separated by the provided separator view, and
+// returns a slice of at most n subviews:
+//
+//	n > 0: at most n subviews; the last subview is the unsplit remainder.
+//	n == 0: the result is nil (zero subviews).
+//	n < 0: all subviews, as returned by Split.
+func (v UnmanagedView[T, Offset]) SplitN(
+	ctx ViewContext[T], sep UnmanagedView[T, Offset], sepCtx ViewContext[T], n int,
+) []UnmanagedView[T, Offset] {
+	return v.genSplit(ctx, sep, sepCtx, 0, n)
+}
+
+// Similar to strings.SplitAfter.
+// Splits the view after each occurrence of the provided separator view, and
+// returns a slice of the resulting subviews. Each subview, except possibly the
+// last one, ends with the separator.
+func (v UnmanagedView[T, Offset]) SplitAfter(
+	ctx ViewContext[T], sep UnmanagedView[T, Offset], sepCtx ViewContext[T],
+) []UnmanagedView[T, Offset] {
+	return v.genSplit(ctx, sep, sepCtx, sep.Len(), -1)
+}
+
+// Similar to strings.FieldsFuncSeq.
+// Returns an iterator over the subviews of the view that are separated by runs
+// of items satisfying f(item), as returned by Fields.
+//
+// The iteration does not allocate, so it is suitable for streaming over large
+// views, or when only the first few fields are needed.
+func (v UnmanagedView[T, Offset]) FieldsSeq(
+	ctx ViewContext[T], f func(T) bool,
+) iter.Seq[UnmanagedView[T, Offset]] {
+	return func(yield func(UnmanagedView[T, Offset]) bool) {
+		start := Offset(0)
+		collecting := false
+
+		for end, item := range v.Range2(ctx) {
+			shouldSplit := f(item)
+			if shouldSplit && collecting {
+				collecting = false
+				if !yield(v.Subview(start, end)) {
+					return
+				}
+			} else if !shouldSplit && !collecting {
+				collecting = true
+				start = end
+			}
+		}
+
+		if collecting {
+			yield(v.Subview(start, v.Len()))
+		}
+	}
+}
+
+// Similar to strings.SplitSeq.
+// Returns an iterator over the subviews of the view that are separated by the
+// provided separator view, as returned by Split.
+//
+// The iteration only allocates the failure table of the separator, once per
+// iteration, and never per subview.
+func (v UnmanagedView[T, Offset]) SplitSeq(
+	ctx ViewContext[T], sep UnmanagedView[T, Offset], sepCtx ViewContext[T],
+) iter.Seq[UnmanagedView[T, Offset]] {
+	return v.splitSeq(ctx, sep, sepCtx, 0, -1)
+}
+
+// Similar to strings.SplitAfterSeq.
+// Returns an iterator over the subviews of the view that are split after each
+// occurrence of the provided separator view, as returned by SplitAfter.
+func (v UnmanagedView[T, Offset]) SplitAfterSeq(
+	ctx ViewContext[T], sep UnmanagedView[T, Offset], sepCtx ViewContext[T],
+) iter.Seq[UnmanagedView[T, Offset]] {
+	return v.splitSeq(ctx, sep, sepCtx, sep.Len(), -1)
+}
+
+// Splits the view after each occurrence of the separator, including sepSave
+// items of the separator in the subviews, into at most n subviews
+// (if n is non-negative).
+func (v UnmanagedView[T, Offset]) genSplit(
+	ctx ViewContext[T],
+	sep UnmanagedView[T, Offset],
+	sepCtx ViewContext[T],
+	sepSave Offset,
+	n int,
+) []UnmanagedView[T, Offset] {
+	if n == 0 {
+		return nil
+	}
+
+	subviews := make([]UnmanagedView[T, Offset], 0)
+	for subview := range v.splitSeq(ctx, sep, sepCtx, sepSave, n) {
+		subviews = append(subviews, subview)
+	}
+	return subviews
+}
+
+// Iterate over the subviews generated by genSplit, without collecting them.
+// The failure table of the separator is the only allocation, and it is
+// performed once per iteration.
+func (v UnmanagedView[T, Offset]) splitSeq(
+	ctx ViewContext[T],
+	sep UnmanagedView[T, Offset],
+	sepCtx ViewContext[T],
+	sepSave Offset,
+	n int,
+) iter.Seq[UnmanagedView[T, Offset]] {
+	return func(yield func(UnmanagedView[T, Offset]) bool) {
+		if n == 0 {
+			return
+		}
+
+		m := sep.Len()
+		if m == 0 {
+			v.explode(n)(yield)
+			return
+		}
+
+		hay := func(i Offset) T { return v.AtUnsafe(ctx, i) }
+		needle := func(i Offset) T { return sep.AtUnsafe(sepCtx, i) }
+		table := kmpFailureTable(m, needle, equal[T])
+
+		start := Offset(0)
+		for count := 1; n < 0 || count < n; count++ {
+			idx, found := kmpIndexFrom(table, v.Len(), hay, start, m, needle, equal[T])
+			if !found {
+				break
+			}
+
+			if !yield(v.Subview(start, idx+sepSave)) {
+				return
+			}
+			start = idx + m
+		}
+
+		yield(v.Subview(start, v.Len()))
+	}
+}
+
+// Iterate over subviews of single items, where the last subview is the
+// unsplit remainder if there are more than n items (if n is positive).
+func (v UnmanagedView[T, Offset]) explode(n int) iter.Seq[UnmanagedView[T, Offset]] {
+	return func(yield func(UnmanagedView[T, Offset]) bool) {
+		for i, count := Offset(0), 1; i < v.Len(); i, count = i+1, count+1 {
+			if count == n {
+				yield(v.Subview(i, v.Len()))
+				return
+			}
+
+			if !yield(v.Subview(i, i+1)) {
+				return
+			}
+		}
+	}
+}
+
+// Similar to strings.TrimLeftFunc.
+// Returns a subview of the view with all leading items satisfying f(item)
+// removed.
+func (v UnmanagedView[T, Offset]) TrimLeftFunc(ctx ViewContext[T], f func(T) bool) UnmanagedView[T, Offset] {
 	start := Offset(0)
-	collecting := false
-
-	for end, item := range v.Range2(ctx) {
-		shouldSplit := f(item)
-		if shouldSplit && collecting {
-			collecting = false
-			subview := UnmanagedView[T, Offset]{Start: start, End: end}
-			fields = append(fields, subview)
-		} else if !shouldSplit && !collecting {
-			collecting = true
-			start = end
+	for start < v.Len() && f(v.AtUnsafe(ctx, start)) {
+		start++
+	}
+	return v.Subview(start, v.Len())
+}
+
+// Similar to strings.TrimRightFunc.
+// Returns a subview of the view with all trailing items satisfying f(item)
+// removed.
+func (v UnmanagedView[T, Offset]) TrimRightFunc(ctx ViewContext[T], f func(T) bool) UnmanagedView[T, Offset] {
+	end := v.Len()
+	for end > 0 && f(v.AtUnsafe(ctx, end-1)) {
+		end--
+	}
+	return v.Subview(0, end)
+}
+
+// Similar to strings.TrimFunc.
+// Returns a subview of the view with all leading and trailing items satisfying
+// f(item) removed.
+func (v UnmanagedView[T, Offset]) TrimFunc(ctx ViewContext[T], f func(T) bool) UnmanagedView[T, Offset] {
+	return v.TrimLeftFunc(ctx, f).TrimRightFunc(ctx, f)
+}
+
+// Similar to strings.TrimLeft.
+// Returns a subview of the view with all leading items that appear in the
+// provided cutset view removed.
+//
+// Each item is looked up in the cutset linearly, so the cutset is expected to
+// be small.
+func (v UnmanagedView[T, Offset]) TrimLeft(
+	ctx ViewContext[T], cutset UnmanagedView[T, Offset], cutsetCtx ViewContext[T],
+) UnmanagedView[T, Offset] {
+	return v.TrimLeftFunc(ctx, func(item T) bool { return cutset.Contains(cutsetCtx, item) })
+}
+
+// Similar to strings.TrimRight.
+// Returns a subview of the view with all trailing items that appear in the
+// provided cutset view removed.
+func (v UnmanagedView[T, Offset]) TrimRight(
+	ctx ViewContext[T], cutset UnmanagedView[T, Offset], cutsetCtx ViewContext[T],
+) UnmanagedView[T, Offset] {
+	return v.TrimRightFunc(ctx, func(item T) bool { return cutset.Contains(cutsetCtx, item) })
+}
+
+// Similar to strings.Trim.
+// Returns a subview of the view with all leading and trailing items that
+// appear in the provided cutset view removed.
+func (v UnmanagedView[T, Offset]) Trim(
+	ctx ViewContext[T], cutset UnmanagedView[T, Offset], cutsetCtx ViewContext[T],
+) UnmanagedView[T, Offset] {
+	return v.TrimFunc(ctx, func(item T) bool { return cutset.Contains(cutsetCtx, item) })
+}
+
+// Similar to strings.TrimPrefix.
+// Returns a subview of the view without the provided leading prefix view.
+// If the view doesn't start with prefix, it is returned unchanged.
+func (v UnmanagedView[T, Offset]) TrimPrefix(
+	ctx ViewContext[T], prefix UnmanagedView[T, Offset], prefixCtx ViewContext[T],
+) UnmanagedView[T, Offset] {
+	after, _ := v.CutPrefix(ctx, prefix, prefixCtx)
+	return after
+}
+
+// Similar to strings.TrimSuffix.
+// Returns a subview of the view without the provided trailing suffix view.
+// If the view doesn't end with suffix, it is returned unchanged.
+func (v UnmanagedView[T, Offset]) TrimSuffix(
+	ctx ViewContext[T], suffix UnmanagedView[T, Offset], suffixCtx ViewContext[T],
+) UnmanagedView[T, Offset] {
+	before, _ := v.CutSuffix(ctx, suffix, suffixCtx)
+	return before
+}
+
+// Similar to strings.Cut.
+// Slices the view around the first occurrence of the provided separator view,
+// returning the subviews before and after the separator, and true.
+// If the separator does not appear in the view, returns the view, an empty
+// subview at its end, and false.
+func (v UnmanagedView[T, Offset]) Cut(
+	ctx ViewContext[T], sep UnmanagedView[T, Offset], sepCtx ViewContext[T],
+) (before, after UnmanagedView[T, Offset], found bool) {
+	idx, found := kmpIndex(
+		v.Len(),
+		func(i Offset) T { return v.AtUnsafe(ctx, i) },
+		sep.Len(),
+		func(i Offset) T { return sep.AtUnsafe(sepCtx, i) },
+		equal[T],
+	)
+
+	if !found {
+		return v, v.Subview(v.Len(), v.Len()), false
+	}
+
+	return v.Subview(0, idx), v.Subview(idx+sep.Len(), v.Len()), true
+}
+
+// Similar to strings.CutPrefix.
+// Returns the view without the provided leading prefix view, and true.
+// If the view doesn't start with prefix, returns the view unchanged, and false.
+func (v UnmanagedView[T, Offset]) CutPrefix(
+	ctx ViewContext[T], prefix UnmanagedView[T, Offset], prefixCtx ViewContext[T],
+) (after UnmanagedView[T, Offset], found bool) {
+	if !v.HasPrefix(ctx, prefix, prefixCtx) {
+		return v, false
+	}
+	return v.Subview(prefix.Len(), v.Len()), true
+}
+
+// Similar to strings.CutSuffix.
+// Returns the view without the provided trailing suffix view, and true.
+// If the view doesn't end with suffix, returns the view unchanged, and false.
+func (v UnmanagedView[T, Offset]) CutSuffix(
+	ctx ViewContext[T], suffix UnmanagedView[T, Offset], suffixCtx ViewContext[T],
+) (before UnmanagedView[T, Offset], found bool) {
+	if !v.HasSuffix(ctx, suffix, suffixCtx) {
+		return v, false
+	}
+	return v.Subview(0, v.Len()-suffix.Len()), true
+}
+
+// Iterate over all subviews of k consecutive items of the view, from the
+// first to the last, similarly to Rust's slice::windows. Consecutive windows
+// overlap in k-1 items. If the view has less than k items, nothing is yielded.
+//
+// Windows panics if k is zero.
+func (v UnmanagedView[T, Offset]) Windows(k Offset) iter.Seq[UnmanagedView[T, Offset]] {
+	if k == 0 {
+		panic("view: window size must be positive")
+	}
+
+	return func(yield func(UnmanagedView[T, Offset]) bool) {
+		if v.Len() < k {
+			return
+		}
+
+		for start := Offset(0); start <= v.Len()-k; start++ {
+			if !yield(v.Subview(start, start+k)) {
+				return
+			}
 		}
 	}
+}
 
-	if collecting {
-		subview := UnmanagedView[T, Offset]{Start: start, End: v.Len()}
-		fields = append(fields, subview)
+// Similar to slices.Chunk.
+// Iterate over consecutive, non-overlapping subviews of k items of the view.
+// All subviews have k items, except possibly the last one, which has the
+// remaining items.
+//
+// Chunks panics if k is zero.
+func (v UnmanagedView[T, Offset]) Chunks(k Offset) iter.Seq[UnmanagedView[T, Offset]] {
+	if k == 0 {
+		panic("view: chunk size must be positive")
 	}
 
-	return fields
+	return func(yield func(UnmanagedView[T, Offset]) bool) {
+		for start := Offset(0); start < v.Len(); {
+			end := start + min(k, v.Len()-start)
+			if !yield(v.Subview(start, end)) {
+				return
+			}
+			start = end
+		}
+	}
 }
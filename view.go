@@ -1,6 +1,9 @@
 package view
 
 import (
+	"hash/maphash"
+	"iter"
+
 	"golang.org/x/exp/constraints"
 )
 
@@ -16,6 +19,43 @@ func NewView[T comparable, Offset constraints.Unsigned](data []T) View[T, Offset
 	return unmanaged.Attach(ctx)
 }
 
+// Create a new (managed) view over an already existing slice, without copying
+// it. The view initially spans over the whole slice.
+//
+// The context of the returned view aliases the provided slice: any
+// modification of the slice is visible through the view, and vice versa.
+// See BorrowUnmanagedView for more details.
+func BorrowView[T comparable, Offset constraints.Unsigned](data []T) View[T, Offset] {
+	unmanaged, ctx := BorrowUnmanagedView[T, Offset](data)
+	return unmanaged.Attach(ctx)
+}
+
+// Create a new (managed) view from an already existing slice, similarly to
+// NewView.
+//
+// Returns an error if the length of the slice cannot be represented by the
+// Offset type, in which case the view would silently be truncated.
+func NewViewChecked[T comparable, Offset constraints.Unsigned](data []T) (View[T, Offset], error) {
+	unmanaged, ctx, err := NewUnmanagedViewChecked[T, Offset](data)
+	if err != nil {
+		return View[T, Offset]{}, err
+	}
+	return unmanaged.Attach(ctx), nil
+}
+
+// Create a new (managed) view over an already existing slice, without copying
+// it, similarly to BorrowView.
+//
+// Returns an error if the length of the slice cannot be represented by the
+// Offset type, in which case the view would silently be truncated.
+func BorrowViewChecked[T comparable, Offset constraints.Unsigned](data []T) (View[T, Offset], error) {
+	unmanaged, ctx, err := BorrowUnmanagedViewChecked[T, Offset](data)
+	if err != nil {
+		return View[T, Offset]{}, err
+	}
+	return unmanaged.Attach(ctx), nil
+}
+
 // Extract the unmanaged view and context from the current view, and return
 // copies of them. The old view is still valid and safe to use.
 func (v View[T, Offset]) Detach() (UnmanagedView[T, Offset], ViewContext[T]) {
@@ -90,6 +130,64 @@ func (v View[T, Offset]) Equal(o View[T, Offset]) bool {
 	return v.unmanaged.Equal(v.ctx, o.unmanaged, o.ctx)
 }
 
+// Returns true if the underlying views are identical in their content, where
+// items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view, and the
+// second is the item of the other view at the same index.
+func (v View[T, Offset]) EqualFunc(o View[T, Offset], eq func(T, T) bool) bool {
+	return v.unmanaged.EqualFunc(v.ctx, o.unmanaged, o.ctx, eq)
+}
+
+// Returns a hash of the content of the view, using the provided seed.
+//
+// The hash is consistent with Equal: views with equal content have equal
+// hashes under the same seed, regardless of their contexts and offsets.
+func (v View[T, Offset]) Hash(seed maphash.Seed) uint64 {
+	return v.unmanaged.Hash(v.ctx, seed)
+}
+
+// Compares the content of the current view and the provided view
+// lexicographically, using the provided comparison function on each pair of
+// items, similarly to slices.CompareFunc.
+//
+// The result is the first non-zero result of cmp. If one view is a prefix of
+// the other, the shorter view is considered the smaller one.
+func (v View[T, Offset]) CompareFunc(o View[T, Offset], cmp func(T, T) int) int {
+	return v.unmanaged.CompareFunc(v.ctx, o.unmanaged, o.ctx, cmp)
+}
+
+// Returns the Levenshtein distance between the current view and the provided
+// one: the minimal number of item insertions, deletions and substitutions
+// required to transform one view into the other.
+func (v View[T, Offset]) Levenshtein(o View[T, Offset]) int {
+	return v.unmanaged.Levenshtein(v.ctx, o.unmanaged, o.ctx)
+}
+
+// Returns the Levenshtein distance between the current view and the provided
+// one, and true, if it is at most bound. Otherwise, returns false as soon as
+// the distance is known to exceed bound, with an undefined distance.
+// A negative bound disables the early exit.
+func (v View[T, Offset]) LevenshteinBounded(o View[T, Offset], bound int) (int, bool) {
+	return v.unmanaged.LevenshteinBounded(v.ctx, o.unmanaged, o.ctx, bound)
+}
+
+// Returns the Damerau-Levenshtein distance between the current view and the
+// provided one, in its optimal string alignment variant: similarly to the
+// Levenshtein distance, but a transposition of two adjacent items also counts
+// as a single edit, as long as no item is edited more than once.
+func (v View[T, Offset]) DamerauLevenshtein(o View[T, Offset]) int {
+	return v.unmanaged.DamerauLevenshtein(v.ctx, o.unmanaged, o.ctx)
+}
+
+// Returns the Damerau-Levenshtein distance between the current view and the
+// provided one, and true, if it is at most bound. Otherwise, returns false as
+// soon as the distance is known to exceed bound, with an undefined distance.
+// A negative bound disables the early exit.
+func (v View[T, Offset]) DamerauLevenshteinBounded(o View[T, Offset], bound int) (int, bool) {
+	return v.unmanaged.DamerauLevenshteinBounded(v.ctx, o.unmanaged, o.ctx, bound)
+}
+
 // Find the first item in the view bounds that equals to the provided item.
 // Return the index of such item (relative to the view start offset).
 //
@@ -106,11 +204,50 @@ func (v View[T, Offset]) IndexFunc(f func(T) bool) Offset {
 	return v.unmanaged.IndexFunc(v.ctx, f)
 }
 
+// Find the last item in the view bounds that equals to the provided item.
+// Return the index of such item (relative to the view start offset).
+//
+// If no item equals to the provided item, returns v.Len().
+func (v View[T, Offset]) LastIndex(item T) Offset {
+	return v.unmanaged.LastIndex(v.ctx, item)
+}
+
+// Find the last item in the view bounds that returns true on the provided predicate.
+// Return the index of such item (relative to the view start offset).
+//
+// If no items return true on the provided predicate, returns v.Len().
+func (v View[T, Offset]) LastIndexFunc(f func(T) bool) Offset {
+	return v.unmanaged.LastIndexFunc(v.ctx, f)
+}
+
+// Find the first item in the view bounds that appears in the provided set view.
+// Return the index of such item (relative to the view start offset).
+//
+// Each item is looked up in the set linearly, so the set is expected to be
+// small. If no item appears in the set, returns v.Len().
+func (v View[T, Offset]) IndexAny(set View[T, Offset]) Offset {
+	return v.unmanaged.IndexAny(v.ctx, set.unmanaged, set.ctx)
+}
+
+// Find the last item in the view bounds that appears in the provided set view.
+// Return the index of such item (relative to the view start offset).
+//
+// If no item appears in the set, returns v.Len().
+func (v View[T, Offset]) LastIndexAny(set View[T, Offset]) Offset {
+	return v.unmanaged.LastIndexAny(v.ctx, set.unmanaged, set.ctx)
+}
+
 // Returns true iff the view contains the provided item.
 func (v View[T, Offset]) Contains(item T) bool {
 	return v.unmanaged.Contains(v.ctx, item)
 }
 
+// Returns true iff the view contains an item that returns true on the
+// provided predicate.
+func (v View[T, Offset]) ContainsFunc(f func(T) bool) bool {
+	return v.unmanaged.ContainsFunc(v.ctx, f)
+}
+
 // Returns true iff the provided view is a prefix of the current view.
 func (v View[T, Offset]) HasPrefix(prefix View[T, Offset]) bool {
 	unmanagedPrefix, prefixCtx := prefix.Detach()
@@ -123,6 +260,81 @@ func (v View[T, Offset]) HasSuffix(suffix View[T, Offset]) bool {
 	return v.unmanaged.HasSuffix(v.ctx, unmanagedSuffix, suffixCtx)
 }
 
+// Returns true iff the provided view is a prefix of the current view, where
+// items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v View[T, Offset]) HasPrefixFunc(prefix View[T, Offset], eq func(T, T) bool) bool {
+	return v.unmanaged.HasPrefixFunc(v.ctx, prefix.unmanaged, prefix.ctx, eq)
+}
+
+// Returns true iff the provided view is a suffix of the current view, where
+// items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v View[T, Offset]) HasSuffixFunc(suffix View[T, Offset], eq func(T, T) bool) bool {
+	return v.unmanaged.HasSuffixFunc(v.ctx, suffix.unmanaged, suffix.ctx, eq)
+}
+
+// Find the first occurrence of the provided needle view in the current view.
+// Return the index of the start of the occurrence (relative to the view start
+// offset). An empty needle is always found at index 0.
+//
+// The search runs in O(n+m) time in the worst case, where n and m are the
+// lengths of the current view and the needle view.
+//
+// If the needle does not appear in the view, returns v.Len().
+func (v View[T, Offset]) IndexView(needle View[T, Offset]) Offset {
+	return v.unmanaged.IndexView(v.ctx, needle.unmanaged, needle.ctx)
+}
+
+// Find the last occurrence of the provided needle view in the current view.
+// Return the index of the start of the occurrence (relative to the view start
+// offset). An empty needle is always found at index v.Len().
+//
+// The search runs in O(n+m) time in the worst case, where n and m are the
+// lengths of the current view and the needle view.
+//
+// If the needle does not appear in the view, returns v.Len().
+func (v View[T, Offset]) LastIndexView(needle View[T, Offset]) Offset {
+	return v.unmanaged.LastIndexView(v.ctx, needle.unmanaged, needle.ctx)
+}
+
+// Returns true iff the provided needle view appears in the current view.
+func (v View[T, Offset]) ContainsView(needle View[T, Offset]) bool {
+	return v.unmanaged.ContainsView(v.ctx, needle.unmanaged, needle.ctx)
+}
+
+// Returns the number of items in the view that equal to the provided item.
+func (v View[T, Offset]) Count(item T) Offset {
+	return v.unmanaged.Count(v.ctx, item)
+}
+
+// Returns the number of items in the view that return true on the provided
+// predicate.
+func (v View[T, Offset]) CountFunc(f func(T) bool) Offset {
+	return v.unmanaged.CountFunc(v.ctx, f)
+}
+
+// Similar to strings.Count.
+// Returns the number of non-overlapping occurrences of the provided needle
+// view in the current view. If the needle is empty, returns v.Len() + 1.
+//
+// The count is returned as an int, since v.Len() + 1 may not fit in Offset.
+func (v View[T, Offset]) CountView(needle View[T, Offset]) int {
+	return v.unmanaged.CountView(v.ctx, needle.unmanaged, needle.ctx)
+}
+
+// Find the first occurrence of the provided needle view in the current view,
+// where items are compared using the provided eq function, which must be an
+// equivalence relation. The first argument of eq is always an item of the
+// current view.
+//
+// See IndexView for more details.
+func (v View[T, Offset]) IndexViewFunc(needle View[T, Offset], eq func(T, T) bool) Offset {
+	return v.unmanaged.IndexViewFunc(v.ctx, needle.unmanaged, needle.ctx, eq)
+}
+
 // Returns the longest common prefix of the current view and the provided view.
 func (v View[T, Offset]) LongestCommonPrefix(u View[T, Offset]) View[T, Offset] {
 	return v.unmanaged.LongestCommonPrefix(v.ctx, u.unmanaged, u.ctx).Attach(v.ctx)
@@ -133,6 +345,22 @@ func (v View[T, Offset]) LongestCommonSuffix(u View[T, Offset]) View[T, Offset]
 	return v.unmanaged.LongestCommonSuffix(v.ctx, u.unmanaged, u.ctx).Attach(v.ctx)
 }
 
+// Returns the longest common prefix of the current view and the provided view,
+// where items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v View[T, Offset]) LongestCommonPrefixFunc(u View[T, Offset], eq func(T, T) bool) View[T, Offset] {
+	return v.unmanaged.LongestCommonPrefixFunc(v.ctx, u.unmanaged, u.ctx, eq).Attach(v.ctx)
+}
+
+// Returns the longest common suffix of the current view and the provided view,
+// where items are compared using the provided eq function.
+//
+// The first argument of eq is always an item of the current view.
+func (v View[T, Offset]) LongestCommonSuffixFunc(u View[T, Offset], eq func(T, T) bool) View[T, Offset] {
+	return v.unmanaged.LongestCommonSuffixFunc(v.ctx, u.unmanaged, u.ctx, eq).Attach(v.ctx)
+}
+
 // Merge this and the other provided view into a one bigger view.
 // This is done by setting newView.Start to min(v.Start, o.Start) and
 // newView.End to max(v.End, o.End).
@@ -176,6 +404,19 @@ func (v View[T, Offset]) Range2() func(func(Offset, T) bool) {
 	return v.unmanaged.Range2(v.ctx)
 }
 
+// Similar to slices.Backward.
+// Iterate over all indices and values in the view, from the last item to the
+// first (rangefunc). Indices are relative to the view start offset.
+func (v View[T, Offset]) Backward() iter.Seq2[Offset, T] {
+	return v.unmanaged.Backward(v.ctx)
+}
+
+// Returns a reversed adapter of the view, which accesses the items of the
+// view from the last to the first, without copying the context.
+func (v View[T, Offset]) Reverse() ReversedView[T, Offset] {
+	return ReversedView[T, Offset]{view: v}
+}
+
 // Similar to strings.FieldsFunc.
 // Splits the input view at each run of items satisfying f(item) and returns an
 // array of subviews of the origin view.
@@ -186,6 +427,178 @@ func (v View[T, Offset]) Fields(f func(T) bool) []View[T, Offset] {
 	return attachMany(v.ctx, v.unmanaged.Fields(v.ctx, f))
 }
 
+// Similar to strings.Split.
+// Splits the view into all subviews separated by the provided separator view,
+// and returns a slice of the subviews between those separators.
+//
+// Empty subviews are kept, so a view with k non-overlapping occurrences of the
+// separator is always split into k+1 subviews. If the separator is empty,
+// the view is split after each item.
+func (v View[T, Offset]) Split(sep View[T, Offset]) []View[T, Offset] {
+	return attachMany(v.ctx, v.unmanaged.Split(v.ctx, sep.unmanaged, sep.ctx))
+}
+
+// Similar to strings.SplitN.
+// Splits the view into subviews separated by the provided separator view, and
+// returns a slice of at most n subviews.
+//
+// See UnmanagedView.SplitN for more details.
+func (v View[T, Offset]) SplitN(sep View[T, Offset], n int) []View[T, Offset] {
+	unmanaged := v.unmanaged.SplitN(v.ctx, sep.unmanaged, sep.ctx, n)
+	if unmanaged == nil {
+		return nil
+	}
+	return attachMany(v.ctx, unmanaged)
+}
+
+// Similar to strings.SplitAfter.
+// Splits the view after each occurrence of the provided separator view, and
+// returns a slice of the resulting subviews. Each subview, except possibly the
+// last one, ends with the separator.
+func (v View[T, Offset]) SplitAfter(sep View[T, Offset]) []View[T, Offset] {
+	return attachMany(v.ctx, v.unmanaged.SplitAfter(v.ctx, sep.unmanaged, sep.ctx))
+}
+
+// Similar to strings.FieldsFuncSeq.
+// Returns an iterator over the subviews of the view that are separated by runs
+// of items satisfying f(item), as returned by Fields.
+//
+// The iteration does not allocate, so it is suitable for streaming over large
+// views, or when only the first few fields are needed.
+func (v View[T, Offset]) FieldsSeq(f func(T) bool) iter.Seq[View[T, Offset]] {
+	return attachSeq(v.ctx, v.unmanaged.FieldsSeq(v.ctx, f))
+}
+
+// Similar to strings.SplitSeq.
+// Returns an iterator over the subviews of the view that are separated by the
+// provided separator view, as returned by Split.
+func (v View[T, Offset]) SplitSeq(sep View[T, Offset]) iter.Seq[View[T, Offset]] {
+	return attachSeq(v.ctx, v.unmanaged.SplitSeq(v.ctx, sep.unmanaged, sep.ctx))
+}
+
+// Similar to strings.SplitAfterSeq.
+// Returns an iterator over the subviews of the view that are split after each
+// occurrence of the provided separator view, as returned by SplitAfter.
+func (v View[T, Offset]) SplitAfterSeq(sep View[T, Offset]) iter.Seq[View[T, Offset]] {
+	return attachSeq(v.ctx, v.unmanaged.SplitAfterSeq(v.ctx, sep.unmanaged, sep.ctx))
+}
+
+// Similar to strings.TrimLeftFunc.
+// Returns a subview of the view with all leading items satisfying f(item)
+// removed.
+func (v View[T, Offset]) TrimLeftFunc(f func(T) bool) View[T, Offset] {
+	return v.unmanaged.TrimLeftFunc(v.ctx, f).Attach(v.ctx)
+}
+
+// Similar to strings.TrimRightFunc.
+// Returns a subview of the view with all trailing items satisfying f(item)
+// removed.
+func (v View[T, Offset]) TrimRightFunc(f func(T) bool) View[T, Offset] {
+	return v.unmanaged.TrimRightFunc(v.ctx, f).Attach(v.ctx)
+}
+
+// Similar to strings.TrimFunc.
+// Returns a subview of the view with all leading and trailing items satisfying
+// f(item) removed.
+func (v View[T, Offset]) TrimFunc(f func(T) bool) View[T, Offset] {
+	return v.unmanaged.TrimFunc(v.ctx, f).Attach(v.ctx)
+}
+
+// Similar to strings.TrimLeft.
+// Returns a subview of the view with all leading items that appear in the
+// provided cutset view removed.
+//
+// Each item is looked up in the cutset linearly, so the cutset is expected to
+// be small.
+func (v View[T, Offset]) TrimLeft(cutset View[T, Offset]) View[T, Offset] {
+	return v.unmanaged.TrimLeft(v.ctx, cutset.unmanaged, cutset.ctx).Attach(v.ctx)
+}
+
+// Similar to strings.TrimRight.
+// Returns a subview of the view with all trailing items that appear in the
+// provided cutset view removed.
+func (v View[T, Offset]) TrimRight(cutset View[T, Offset]) View[T, Offset] {
+	return v.unmanaged.TrimRight(v.ctx, cutset.unmanaged, cutset.ctx).Attach(v.ctx)
+}
+
+// Similar to strings.Trim.
+// Returns a subview of the view with all leading and trailing items that
+// appear in the provided cutset view removed.
+func (v View[T, Offset]) Trim(cutset View[T, Offset]) View[T, Offset] {
+	return v.unmanaged.Trim(v.ctx, cutset.unmanaged, cutset.ctx).Attach(v.ctx)
+}
+
+// Similar to strings.TrimPrefix.
+// Returns a subview of the view without the provided leading prefix view.
+// If the view doesn't start with prefix, it is returned unchanged.
+func (v View[T, Offset]) TrimPrefix(prefix View[T, Offset]) View[T, Offset] {
+	return v.unmanaged.TrimPrefix(v.ctx, prefix.unmanaged, prefix.ctx).Attach(v.ctx)
+}
+
+// Similar to strings.TrimSuffix.
+// Returns a subview of the view without the provided trailing suffix view.
+// If the view doesn't end with suffix, it is returned unchanged.
+func (v View[T, Offset]) TrimSuffix(suffix View[T, Offset]) View[T, Offset] {
+	return v.unmanaged.TrimSuffix(v.ctx, suffix.unmanaged, suffix.ctx).Attach(v.ctx)
+}
+
+// Similar to strings.Cut.
+// Slices the view around the first occurrence of the provided separator view,
+// returning the subviews before and after the separator, and true.
+// If the separator does not appear in the view, returns the view, an empty
+// subview at its end, and false.
+func (v View[T, Offset]) Cut(sep View[T, Offset]) (before, after View[T, Offset], found bool) {
+	b, a, found := v.unmanaged.Cut(v.ctx, sep.unmanaged, sep.ctx)
+	return b.Attach(v.ctx), a.Attach(v.ctx), found
+}
+
+// Similar to strings.CutPrefix.
+// Returns the view without the provided leading prefix view, and true.
+// If the view doesn't start with prefix, returns the view unchanged, and false.
+func (v View[T, Offset]) CutPrefix(prefix View[T, Offset]) (after View[T, Offset], found bool) {
+	a, found := v.unmanaged.CutPrefix(v.ctx, prefix.unmanaged, prefix.ctx)
+	return a.Attach(v.ctx), found
+}
+
+// Similar to strings.CutSuffix.
+// Returns the view without the provided trailing suffix view, and true.
+// If the view doesn't end with suffix, returns the view unchanged, and false.
+func (v View[T, Offset]) CutSuffix(suffix View[T, Offset]) (before View[T, Offset], found bool) {
+	b, found := v.unmanaged.CutSuffix(v.ctx, suffix.unmanaged, suffix.ctx)
+	return b.Attach(v.ctx), found
+}
+
+// Iterate over all subviews of k consecutive items of the view, from the
+// first to the last, similarly to Rust's slice::windows. Consecutive windows
+// overlap in k-1 items. If the view has less than k items, nothing is yielded.
+//
+// Windows panics if k is zero.
+func (v View[T, Offset]) Windows(k Offset) iter.Seq[View[T, Offset]] {
+	return attachSeq(v.ctx, v.unmanaged.Windows(k))
+}
+
+// Similar to slices.Chunk.
+// Iterate over consecutive, non-overlapping subviews of k items of the view.
+// All subviews have k items, except possibly the last one, which has the
+// remaining items.
+//
+// Chunks panics if k is zero.
+func (v View[T, Offset]) Chunks(k Offset) iter.Seq[View[T, Offset]] {
+	return attachSeq(v.ctx, v.unmanaged.Chunks(k))
+}
+
+func attachSeq[T comparable, Offset constraints.Unsigned](
+	ctx ViewContext[T], seq iter.Seq[UnmanagedView[T, Offset]],
+) iter.Seq[View[T, Offset]] {
+	return func(yield func(View[T, Offset]) bool) {
+		for unmanaged := range seq {
+			if !yield(unmanaged.Attach(ctx)) {
+				return
+			}
+		}
+	}
+}
+
 func attachMany[T comparable, Offset constraints.Unsigned](
 	ctx ViewContext[T], many []UnmanagedView[T, Offset],
 ) []View[T, Offset] {
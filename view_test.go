@@ -1,6 +1,9 @@
 package view_test
 
 import (
+	"math/rand/v2"
+	"slices"
+	"strings"
 	"testing"
 	"unicode"
 
@@ -135,6 +138,54 @@ func TestHasSuffixSimpleCase(t *testing.T) {
 	assert.True(t, v.HasSuffix(suffix))
 }
 
+func TestIndexViewSimpleCase(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("xxabcabcabd")).Subview(2, 11)
+	needle := view.NewView[rune, uint]([]rune("abcabd"))
+	assert.EqualValues(t, 3, v.IndexView(needle))
+	assert.True(t, v.ContainsView(needle))
+}
+
+func TestIndexViewNotFound(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("aaaaaaab")).Subview(0, 7)
+	needle := view.NewView[rune, uint]([]rune("aab"))
+	assert.EqualValues(t, v.Len(), v.IndexView(needle))
+	assert.EqualValues(t, v.Len(), v.LastIndexView(needle))
+	assert.False(t, v.ContainsView(needle))
+}
+
+func TestIndexViewEmptyNeedle(t *testing.T) {
+	v := view.NewView[int, uint]([]int{1, 2, 3})
+	needle := view.NewView[int, uint]([]int{})
+	assert.EqualValues(t, 0, v.IndexView(needle))
+	assert.EqualValues(t, 3, v.LastIndexView(needle))
+	assert.True(t, v.ContainsView(needle))
+}
+
+func TestLastIndexViewSimpleCase(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("abaababaab")).Subview(1, 10)
+	needle := view.NewView[rune, uint]([]rune("aba"))
+	assert.EqualValues(t, 4, v.LastIndexView(needle))
+}
+
+func TestIndexViewMatchesNaive(t *testing.T) {
+	hay := []int{0, 1, 0, 1, 1, 0, 1, 0, 1, 1, 0, 1, 0}
+	v := view.NewView[int, uint](hay)
+	for start := 0; start <= len(hay); start++ {
+		for end := start; end <= len(hay) && end-start <= 5; end++ {
+			needle := view.NewView[int, uint](hay[start:end])
+			first, last := len(hay), len(hay)
+			for i := 0; i+end-start <= len(hay); i++ {
+				if slices.Equal(hay[i:i+end-start], hay[start:end]) {
+					first = min(first, i)
+					last = i
+				}
+			}
+			assert.EqualValues(t, first, v.IndexView(needle))
+			assert.EqualValues(t, last, v.LastIndexView(needle))
+		}
+	}
+}
+
 func TestLongestCommonPrefixSimpleCase(t *testing.T) {
 	v := view.NewView[int, uint]([]int{0, 1, 2, 3, 4, 5}).Subview(1, 6)
 	u := view.NewView[int, uint]([]int{1, 2, 4, 5})
@@ -239,3 +290,365 @@ func TestFields(t *testing.T) {
 	expected := [][]rune{[]rune("foo1"), []rune("bar2"), []rune("baz3")}
 	assert.Equal(t, expected, got)
 }
+
+func TestNewViewCopies(t *testing.T) {
+	data := []int{1, 2, 3}
+	v := view.NewView[int, uint](data)
+	data[0] = 4
+	assert.Equal(t, []int{1, 2, 3}, v.Raw())
+}
+
+func TestBorrowView(t *testing.T) {
+	data := []int{1, 2, 3}
+	v := view.BorrowView[int, uint](data)
+	assert.Equal(t, []int{1, 2, 3}, v.Raw())
+
+	data[0] = 4
+	assert.Equal(t, []int{4, 2, 3}, v.Raw())
+	assert.Same(t, &data[0], &v.Ctx()[0])
+}
+
+func TestNewViewChecked(t *testing.T) {
+	v, err := view.NewViewChecked[int, uint8](make([]int, 255))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 255, v.Len())
+
+	_, err = view.NewViewChecked[int, uint8](make([]int, 256))
+	assert.Error(t, err)
+}
+
+func TestBorrowViewChecked(t *testing.T) {
+	_, err := view.BorrowViewChecked[byte, uint8](make([]byte, 300))
+	assert.Error(t, err)
+
+	_, err = view.BorrowViewChecked[byte, uint16](make([]byte, 300))
+	assert.NoError(t, err)
+}
+
+func TestAtOffsetOverflow(t *testing.T) {
+	data := make([]int, 200)
+	for i := range data {
+		data[i] = i
+	}
+
+	v := view.NewView[int, uint8](data).Subview(100, 200)
+	item, err := v.At(99)
+	assert.NoError(t, err)
+	assert.Equal(t, 199, item)
+
+	_, err = v.At(200)
+	assert.Error(t, err)
+}
+
+func TestCheckValid(t *testing.T) {
+	ctx := view.ViewContext[int]{1, 2, 3}
+	v := view.UnmanagedView[int, uint]{Start: 1, End: 3}
+	assert.NoError(t, v.Check(ctx))
+	assert.True(t, v.Valid(ctx))
+
+	attached, err := v.AttachChecked(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, attached.Raw())
+}
+
+func TestCheckReversedBounds(t *testing.T) {
+	ctx := view.ViewContext[int]{1, 2, 3}
+	v := view.UnmanagedView[int, uint]{Start: 2, End: 1}
+	assert.Error(t, v.Check(ctx))
+	assert.False(t, v.Valid(ctx))
+
+	_, err := v.AttachChecked(ctx)
+	assert.Error(t, err)
+}
+
+func TestCheckShortContext(t *testing.T) {
+	v := view.UnmanagedView[int, uint]{Start: 0, End: 4}
+	assert.Error(t, v.Check(view.ViewContext[int]{1, 2, 3}))
+	assert.NoError(t, v.Check(view.ViewContext[int]{1, 2, 3, 4}))
+}
+
+func foldASCII(a, b rune) bool {
+	return unicode.ToLower(a) == unicode.ToLower(b)
+}
+
+func TestEqualFunc(t *testing.T) {
+	a := view.NewView[rune, uint]([]rune("xxHello")).Subview(2, 7)
+	b := view.NewView[rune, uint]([]rune("hELLO"))
+	assert.False(t, a.Equal(b))
+	assert.True(t, a.EqualFunc(b, foldASCII))
+	assert.False(t, a.Subview(0, 4).EqualFunc(b, foldASCII))
+}
+
+func TestContainsFunc(t *testing.T) {
+	v := view.NewView[int, uint]([]int{1, 2, 3, 4}).Subview(0, 3)
+	assert.True(t, v.ContainsFunc(func(n int) bool { return n > 2 }))
+	assert.False(t, v.ContainsFunc(func(n int) bool { return n > 3 }))
+}
+
+func TestHasPrefixSuffixFunc(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("MOV r0, r1"))
+	assert.True(t, v.HasPrefixFunc(view.NewView[rune, uint]([]rune("mov")), foldASCII))
+	assert.False(t, v.HasPrefixFunc(view.NewView[rune, uint]([]rune("add")), foldASCII))
+	assert.True(t, v.HasSuffixFunc(view.NewView[rune, uint]([]rune("R1")), foldASCII))
+	assert.False(t, v.HasSuffixFunc(view.NewView[rune, uint]([]rune("r0")), foldASCII))
+}
+
+func TestIndexViewFunc(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("aaaAAb"))
+	needle := view.NewView[rune, uint]([]rune("aab"))
+	assert.EqualValues(t, 3, v.IndexViewFunc(needle, foldASCII))
+	assert.EqualValues(t, v.Len(), v.IndexView(needle))
+}
+
+func TestLongestCommonPrefixSuffixFunc(t *testing.T) {
+	v := view.NewView[rune, uint]([]rune("FooBarBaz"))
+	u := view.NewView[rune, uint]([]rune("foobazbaz"))
+	assert.Equal(t, []rune("FooBa"), v.LongestCommonPrefixFunc(u, foldASCII).Raw())
+	assert.Equal(t, []rune("Baz"), v.LongestCommonSuffixFunc(u, foldASCII).Raw())
+	assert.Equal(t, []rune("az"), v.LongestCommonSuffix(u).Raw())
+}
+
+func viewStrings(views []view.View[byte, uint]) []string {
+	if views == nil {
+		return nil
+	}
+
+	strs := make([]string, len(views))
+	for idx, v := range views {
+		strs[idx] = string(v.Raw())
+	}
+	return strs
+}
+
+// Returns the absolute spans of consecutive pieces of a view that starts at
+// the provided offset, where consecutive pieces are separated by gap items.
+func piecesSpans(start uint, pieces []string, gap int) []span {
+	spans := make([]span, len(pieces))
+	for idx, piece := range pieces {
+		spans[idx] = span{start, start + uint(len(piece))}
+		start += uint(len(piece) + gap)
+	}
+	return spans
+}
+
+func TestSplitMatchesStrings(t *testing.T) {
+	seps := []string{"", "=", "==", "a", "ab", "aa", "x"}
+
+	random := rand.New(rand.NewPCG(1, 19))
+	for iteration := 0; iteration < 300; iteration++ {
+		v, start, items := randomNestedView(random, textAlphabet)
+		input := string(items)
+
+		for _, sepStr := range seps {
+			sep := view.NewView[byte, uint]([]byte(sepStr))
+
+			expected := strings.Split(input, sepStr)
+			assert.Equal(t, expected, viewStrings(v.Split(sep)), "%q %q", input, sepStr)
+			assert.Equal(t, piecesSpans(start, expected, len(sepStr)), spansOf(v.Split(sep)))
+
+			expected = strings.SplitAfter(input, sepStr)
+			assert.Equal(t, expected, viewStrings(v.SplitAfter(sep)), "%q %q", input, sepStr)
+			assert.Equal(t, piecesSpans(start, expected, 0), spansOf(v.SplitAfter(sep)))
+
+			for n := -1; n <= 4; n++ {
+				expected = strings.SplitN(input, sepStr, n)
+				assert.Equal(t, expected, viewStrings(v.SplitN(sep, n)), "%q %q %d", input, sepStr, n)
+				if expected != nil {
+					assert.Equal(t, piecesSpans(start, expected, len(sepStr)), spansOf(v.SplitN(sep, n)))
+				}
+			}
+		}
+	}
+}
+
+func TestFieldsSeq(t *testing.T) {
+	input := "  foo1;bar2,baz3..."
+	isSep := func(c byte) bool { return !unicode.IsLetter(rune(c)) && !unicode.IsNumber(rune(c)) }
+	v := view.NewView[byte, uint]([]byte("x"+input)).Subview(1, uint(len(input))+1)
+
+	got := []string{}
+	for field := range v.FieldsSeq(isSep) {
+		got = append(got, string(field.Raw()))
+	}
+	assert.Equal(t, []string{"foo1", "bar2", "baz3"}, got)
+
+	got = []string{}
+	for field := range v.FieldsSeq(isSep) {
+		got = append(got, string(field.Raw()))
+		break
+	}
+	assert.Equal(t, []string{"foo1"}, got)
+}
+
+func TestSplitSeq(t *testing.T) {
+	seps := []string{"", "=", "==", "ab"}
+
+	random := rand.New(rand.NewPCG(1, 20))
+	for iteration := 0; iteration < 300; iteration++ {
+		v, _, _ := randomNestedView(random, textAlphabet)
+		for _, sepStr := range seps {
+			sep := view.NewView[byte, uint]([]byte(sepStr))
+			assert.Equal(t, spansOf(v.Split(sep)), spansOf(slices.AppendSeq([]view.View[byte, uint]{}, v.SplitSeq(sep))))
+			assert.Equal(t, spansOf(v.SplitAfter(sep)), spansOf(slices.AppendSeq([]view.View[byte, uint]{}, v.SplitAfterSeq(sep))))
+		}
+	}
+}
+
+func TestSeqAllocationsDoNotDependOnFieldCount(t *testing.T) {
+	allocs := func(fields int) float64 {
+		v := view.NewView[byte, uint]([]byte(strings.Repeat("ab,", fields)))
+		sep := view.NewView[byte, uint]([]byte(","))
+		isComma := func(c byte) bool { return c == ',' }
+		return testing.AllocsPerRun(10, func() {
+			for range v.FieldsSeq(isComma) {
+			}
+			for range v.SplitSeq(sep) {
+			}
+		})
+	}
+
+	assert.Equal(t, allocs(1), allocs(1000))
+}
+
+func TestTrimMatchesStrings(t *testing.T) {
+	cutsets := []string{"", " ", "ab", "=x"}
+	isSpace := func(c byte) bool { return c == ' ' }
+
+	random := rand.New(rand.NewPCG(1, 22))
+	for iteration := 0; iteration < 300; iteration++ {
+		v, start, items := randomNestedView(random, textAlphabet)
+		input := string(items)
+		end := start + uint(len(input))
+
+		// Trimming from the left keeps the end of the view, and trimming from
+		// the right keeps its start.
+		left := func(trimmed string) span { return span{end - uint(len(trimmed)), end} }
+		right := func(trimmed string) span { return span{start, start + uint(len(trimmed))} }
+		both := func(leftTrimmed, trimmed string) span {
+			begin := end - uint(len(leftTrimmed))
+			return span{begin, begin + uint(len(trimmed))}
+		}
+
+		trimmed := strings.TrimLeftFunc(input, unicode.IsSpace)
+		assertSubview(t, left(trimmed), trimmed, v.TrimLeftFunc(isSpace))
+		trimmed = strings.TrimRightFunc(input, unicode.IsSpace)
+		assertSubview(t, right(trimmed), trimmed, v.TrimRightFunc(isSpace))
+		trimmed = strings.TrimFunc(input, unicode.IsSpace)
+		assertSubview(t, both(strings.TrimLeftFunc(input, unicode.IsSpace), trimmed), trimmed, v.TrimFunc(isSpace))
+
+		for _, cutsetStr := range cutsets {
+			cutset := view.NewView[byte, uint]([]byte(cutsetStr))
+
+			trimmed = strings.TrimLeft(input, cutsetStr)
+			assertSubview(t, left(trimmed), trimmed, v.TrimLeft(cutset))
+			trimmed = strings.TrimRight(input, cutsetStr)
+			assertSubview(t, right(trimmed), trimmed, v.TrimRight(cutset))
+			trimmed = strings.Trim(input, cutsetStr)
+			assertSubview(t, both(strings.TrimLeft(input, cutsetStr), trimmed), trimmed, v.Trim(cutset))
+			trimmed = strings.TrimPrefix(input, cutsetStr)
+			assertSubview(t, left(trimmed), trimmed, v.TrimPrefix(cutset))
+			trimmed = strings.TrimSuffix(input, cutsetStr)
+			assertSubview(t, right(trimmed), trimmed, v.TrimSuffix(cutset))
+		}
+	}
+}
+
+func TestCutMatchesStrings(t *testing.T) {
+	seps := []string{"", "=", "==", "ab", "x"}
+
+	random := rand.New(rand.NewPCG(2, 22))
+	for iteration := 0; iteration < 300; iteration++ {
+		v, start, items := randomNestedView(random, textAlphabet)
+		input := string(items)
+		end := start + uint(len(input))
+
+		for _, sepStr := range seps {
+			sep := view.NewView[byte, uint]([]byte(sepStr))
+
+			expectedBefore, expectedAfter, expectedFound := strings.Cut(input, sepStr)
+			before, after, found := v.Cut(sep)
+			assertSubview(t, span{start, start + uint(len(expectedBefore))}, expectedBefore, before)
+			assertSubview(t, span{end - uint(len(expectedAfter)), end}, expectedAfter, after)
+			assert.Equal(t, expectedFound, found)
+
+			expectedAfter, expectedFound = strings.CutPrefix(input, sepStr)
+			after, found = v.CutPrefix(sep)
+			assertSubview(t, span{end - uint(len(expectedAfter)), end}, expectedAfter, after)
+			assert.Equal(t, expectedFound, found)
+
+			expectedBefore, expectedFound = strings.CutSuffix(input, sepStr)
+			before, found = v.CutSuffix(sep)
+			assertSubview(t, span{start, start + uint(len(expectedBefore))}, expectedBefore, before)
+			assert.Equal(t, expectedFound, found)
+		}
+	}
+}
+
+func TestTrimReturnsSubviews(t *testing.T) {
+	data := []byte("  abc  ")
+	v := view.BorrowView[byte, uint](data)
+	trimmed := v.TrimFunc(func(c byte) bool { return c == ' ' })
+	assert.Equal(t, view.UnmanagedView[byte, uint]{Start: 2, End: 5}, trimmed.Unmanaged())
+	assert.Same(t, &data[0], &trimmed.Ctx()[0])
+}
+
+func TestLastIndex(t *testing.T) {
+	v := view.NewView[int, uint]([]int{1, 2, 3, 2, 1, 9}).Subview(0, 5)
+	assert.Equal(t, uint(3), v.LastIndex(2))
+	assert.Equal(t, uint(5), v.LastIndex(9))
+	assert.Equal(t, uint(2), v.LastIndexFunc(func(i int) bool { return i > 2 }))
+	assert.Equal(t, uint(5), v.LastIndexFunc(func(i int) bool { return i > 3 }))
+}
+
+func TestIndexAnyMatchesStrings(t *testing.T) {
+	sets := []string{"", "a", "b=", "ab ", "x"}
+
+	random := rand.New(rand.NewPCG(1, 23))
+	for iteration := 0; iteration < 300; iteration++ {
+		v, _, items := randomNestedView(random, textAlphabet)
+		input := string(items)
+
+		for _, setStr := range sets {
+			set := view.NewView[byte, uint]([]byte(setStr))
+
+			expected := strings.IndexAny(input, setStr)
+			if expected < 0 {
+				expected = len(input)
+			}
+			assert.Equal(t, uint(expected), v.IndexAny(set))
+
+			expected = strings.LastIndexAny(input, setStr)
+			if expected < 0 {
+				expected = len(input)
+			}
+			assert.Equal(t, uint(expected), v.LastIndexAny(set))
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	v := view.NewView[int, uint]([]int{1, 2, 1, 1, 3}).Subview(1, 5)
+	assert.Equal(t, uint(2), v.Count(1))
+	assert.Equal(t, uint(0), v.Count(4))
+	assert.Equal(t, uint(3), v.CountFunc(func(i int) bool { return i < 3 }))
+}
+
+func TestCountViewMatchesStrings(t *testing.T) {
+	needles := []string{"", "a", "aa", "aba", "= ", "x"}
+
+	random := rand.New(rand.NewPCG(2, 23))
+	for iteration := 0; iteration < 300; iteration++ {
+		v, _, items := randomNestedView(random, textAlphabet)
+		input := string(items)
+
+		for _, needleStr := range needles {
+			needle := view.NewView[byte, uint]([]byte(needleStr))
+			assert.Equal(t, strings.Count(input, needleStr), v.CountView(needle), "%q %q", input, needleStr)
+		}
+	}
+}
+
+func TestCountViewEmptyNeedleOnFullOffsetRange(t *testing.T) {
+	v := view.NewView[byte, uint8](make([]byte, 255))
+	assert.Equal(t, 256, v.CountView(view.NewView[byte, uint8](nil)))
+}
@@ -0,0 +1,159 @@
+package view
+
+import (
+	"hash/maphash"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A ViewMap is a hash map keyed by the content of views, rather than by their
+// contexts and offsets. Views from different contexts with equal content
+// refer to the same entry.
+//
+// The map holds the key views, and not copies of their content, so the
+// contexts of the keys must not be modified while they are in the map.
+//
+// A ViewMap must be created with NewViewMap, and is not safe for concurrent
+// use without external synchronization.
+type ViewMap[T comparable, Offset constraints.Unsigned, V any] struct {
+	seed    maphash.Seed
+	buckets map[uint64][]viewMapEntry[T, Offset, V]
+	len     int
+}
+
+type viewMapEntry[T comparable, Offset constraints.Unsigned, V any] struct {
+	key   View[T, Offset]
+	value V
+}
+
+// Create a new, empty view map.
+func NewViewMap[T comparable, Offset constraints.Unsigned, V any]() *ViewMap[T, Offset, V] {
+	return &ViewMap[T, Offset, V]{
+		seed:    maphash.MakeSeed(),
+		buckets: make(map[uint64][]viewMapEntry[T, Offset, V]),
+	}
+}
+
+// Returns the number of entries in the map.
+func (m *ViewMap[T, Offset, V]) Len() int {
+	return m.len
+}
+
+// Returns the value associated with a key equal in content to the provided
+// key, and true, or the zero value and false if no such key exists.
+func (m *ViewMap[T, Offset, V]) Get(key View[T, Offset]) (V, bool) {
+	for _, entry := range m.buckets[key.Hash(m.seed)] {
+		if entry.key.Equal(key) {
+			return entry.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Associate the provided value with the content of the provided key.
+// If an equal key already exists in the map, its value is replaced, and the
+// existing key view is kept.
+func (m *ViewMap[T, Offset, V]) Set(key View[T, Offset], value V) {
+	hash := key.Hash(m.seed)
+	bucket := m.buckets[hash]
+	for i := range bucket {
+		if bucket[i].key.Equal(key) {
+			bucket[i].value = value
+			return
+		}
+	}
+
+	m.buckets[hash] = append(bucket, viewMapEntry[T, Offset, V]{key: key, value: value})
+	m.len++
+}
+
+// Remove the entry with a key equal in content to the provided key.
+// Returns true iff such an entry existed.
+func (m *ViewMap[T, Offset, V]) Delete(key View[T, Offset]) bool {
+	hash := key.Hash(m.seed)
+	bucket := m.buckets[hash]
+	for i := range bucket {
+		if bucket[i].key.Equal(key) {
+			last := len(bucket) - 1
+			bucket[i] = bucket[last]
+			bucket[last] = viewMapEntry[T, Offset, V]{}
+
+			if last == 0 {
+				delete(m.buckets, hash)
+			} else {
+				m.buckets[hash] = bucket[:last]
+			}
+
+			m.len--
+			return true
+		}
+	}
+
+	return false
+}
+
+// Iterate over all entries in the map, in an unspecified order (rangefunc).
+func (m *ViewMap[T, Offset, V]) All() iter.Seq2[View[T, Offset], V] {
+	return func(yield func(View[T, Offset], V) bool) {
+		for _, bucket := range m.buckets {
+			for _, entry := range bucket {
+				if !yield(entry.key, entry.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// A ViewSet is a hash set of views, deduplicated by their content.
+// See ViewMap for more details.
+type ViewSet[T comparable, Offset constraints.Unsigned] struct {
+	views *ViewMap[T, Offset, struct{}]
+}
+
+// Create a new, empty view set.
+func NewViewSet[T comparable, Offset constraints.Unsigned]() *ViewSet[T, Offset] {
+	return &ViewSet[T, Offset]{views: NewViewMap[T, Offset, struct{}]()}
+}
+
+// Returns the number of views in the set.
+func (s *ViewSet[T, Offset]) Len() int {
+	return s.views.Len()
+}
+
+// Add the provided view to the set.
+// Returns true iff no view with equal content was already in the set.
+func (s *ViewSet[T, Offset]) Add(v View[T, Offset]) bool {
+	if s.Contains(v) {
+		return false
+	}
+
+	s.views.Set(v, struct{}{})
+	return true
+}
+
+// Returns true iff a view with content equal to the provided one is in the set.
+func (s *ViewSet[T, Offset]) Contains(v View[T, Offset]) bool {
+	_, ok := s.views.Get(v)
+	return ok
+}
+
+// Remove the view with content equal to the provided one from the set.
+// Returns true iff such a view existed.
+func (s *ViewSet[T, Offset]) Delete(v View[T, Offset]) bool {
+	return s.views.Delete(v)
+}
+
+// Iterate over all views in the set, in an unspecified order (rangefunc).
+func (s *ViewSet[T, Offset]) All() iter.Seq[View[T, Offset]] {
+	return func(yield func(View[T, Offset]) bool) {
+		for v := range s.views.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package view_test
+
+import (
+	"hash/maphash"
+	"testing"
+
+	"alon.kr/x/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashConsistentWithEqual(t *testing.T) {
+	seed := maphash.MakeSeed()
+	a := view.NewView[rune, uint]([]rune("foo bar")).Subview(4, 7)
+	b := view.NewView[rune, uint]([]rune("bar"))
+	c := view.NewView[rune, uint]([]rune("baz"))
+
+	assert.Equal(t, a.Hash(seed), b.Hash(seed))
+	assert.NotEqual(t, a.Hash(seed), c.Hash(seed))
+
+	ua, actx := a.Detach()
+	assert.Equal(t, a.Hash(seed), ua.Hash(actx, seed))
+}
+
+func TestHashBytes(t *testing.T) {
+	seed := maphash.MakeSeed()
+	a := view.NewView[byte, uint]([]byte("xyz")).Subview(1, 3)
+	b := view.NewView[byte, uint]([]byte("yz"))
+	assert.Equal(t, a.Hash(seed), b.Hash(seed))
+}
+
+func TestViewMap(t *testing.T) {
+	m := view.NewViewMap[rune, uint, int]()
+	text := view.NewView[rune, uint]([]rune("foo bar foo"))
+
+	m.Set(text.Subview(0, 3), 1)
+	m.Set(text.Subview(4, 7), 2)
+	m.Set(text.Subview(8, 11), 3)
+	assert.Equal(t, 2, m.Len())
+
+	value, ok := m.Get(view.NewView[rune, uint]([]rune("foo")))
+	assert.True(t, ok)
+	assert.Equal(t, 3, value)
+
+	_, ok = m.Get(view.NewView[rune, uint]([]rune("baz")))
+	assert.False(t, ok)
+
+	assert.True(t, m.Delete(view.NewView[rune, uint]([]rune("bar"))))
+	assert.False(t, m.Delete(view.NewView[rune, uint]([]rune("bar"))))
+	assert.Equal(t, 1, m.Len())
+
+	for key, value := range m.All() {
+		assert.Equal(t, []rune("foo"), key.Raw())
+		assert.Equal(t, 3, value)
+		assert.EqualValues(t, 0, key.Unmanaged().Start)
+	}
+}
+
+func TestViewSet(t *testing.T) {
+	s := view.NewViewSet[int, uint]()
+	v := view.NewView[int, uint]([]int{1, 2, 1, 2, 3})
+
+	assert.True(t, s.Add(v.Subview(0, 2)))
+	assert.False(t, s.Add(v.Subview(2, 4)))
+	assert.True(t, s.Add(v.Subview(2, 5)))
+	assert.True(t, s.Add(v.Subview(0, 0)))
+	assert.Equal(t, 3, s.Len())
+
+	assert.True(t, s.Contains(view.NewView[int, uint]([]int{1, 2, 3})))
+	assert.True(t, s.Contains(view.NewView[int, uint](nil)))
+	assert.False(t, s.Contains(view.NewView[int, uint]([]int{2, 3})))
+
+	count := 0
+	for range s.All() {
+		count++
+	}
+	assert.Equal(t, 3, count)
+
+	assert.True(t, s.Delete(v.Subview(3, 3)))
+	assert.Equal(t, 2, s.Len())
+}